@@ -0,0 +1,63 @@
+package types
+
+type Budget struct {
+	Metadata
+	BudgetManifest
+}
+
+// BudgetManifest limits the LLM usage in a window of time. The usage of a budget is the usage that matches all of its
+// scope fields: the project, the agent, the users, and the model. A budget with none of them set limits all usage.
+type BudgetManifest struct {
+	Name      string `json:"name,omitempty"`
+	ProjectID string `json:"projectID,omitempty"`
+	// AgentID is the ID of an agent. The agent of usage is only recorded since budgets were added, so a budget for an
+	// agent does not count older usage.
+	AgentID string   `json:"agentID,omitempty"`
+	UserIDs []string `json:"userIDs,omitempty"`
+	// Model is the ID of a model. Requests are sent to the fallbacks of the model when a hard limit of the budget is
+	// reached, instead of being blocked.
+	Model  string       `json:"model,omitempty"`
+	Window BudgetWindow `json:"window"`
+	// Soft is the usage at which the budget warns that it is almost used up.
+	Soft BudgetLimit `json:"soft,omitzero"`
+	// Hard is the usage at which requests are blocked until the next window.
+	Hard BudgetLimit `json:"hard,omitzero"`
+}
+
+// BudgetLimit is a number of tokens, a cost in dollars, or both. A limit of zero is not enforced.
+type BudgetLimit struct {
+	Tokens int     `json:"tokens,omitempty"`
+	Cost   float64 `json:"cost,omitempty"`
+}
+
+type BudgetWindow string
+
+const (
+	BudgetWindowDaily   BudgetWindow = "daily"
+	BudgetWindowWeekly  BudgetWindow = "weekly"
+	BudgetWindowMonthly BudgetWindow = "monthly"
+)
+
+type BudgetList List[Budget]
+
+type BudgetState string
+
+const (
+	BudgetStateOK       BudgetState = "ok"
+	BudgetStateWarning  BudgetState = "warning"
+	BudgetStateExceeded BudgetState = "exceeded"
+)
+
+// RemainingBudget is the usage of a budget in its current window, and how much of its hard limit is left.
+type RemainingBudget struct {
+	BudgetID        string      `json:"budgetID"`
+	Start           Time        `json:"start"`
+	End             Time        `json:"end"`
+	UsedTokens      int         `json:"usedTokens"`
+	UsedCost        float64     `json:"usedCost"`
+	RemainingTokens int         `json:"remainingTokens"`
+	RemainingCost   float64     `json:"remainingCost"`
+	UnlimitedTokens bool        `json:"unlimitedTokens"`
+	UnlimitedCost   bool        `json:"unlimitedCost"`
+	State           BudgetState `json:"state"`
+}
@@ -6,6 +6,7 @@ type CronJob struct {
 	LastRunStartedAt           *Time `json:"lastRunStartedAt,omitempty"`
 	LastSuccessfulRunCompleted *Time `json:"lastSuccessfulRunCompleted,omitempty"`
 	NextRunAt                  *Time `json:"nextRunAt,omitempty"`
+	RunCount                   int   `json:"runCount,omitempty"`
 }
 
 type CronJobManifest struct {
@@ -14,6 +15,40 @@ type CronJobManifest struct {
 	WorkflowName string    `json:"workflowName,omitempty"`
 	Input        string    `json:"input,omitempty"`
 	TaskSchedule *Schedule `json:"taskSchedule,omitempty"`
+	// ScheduleOptions apply to Schedule. The options of TaskSchedule are used when it is set.
+	ScheduleOptions `json:",inline"`
+}
+
+type CatchUpPolicy string
+
+const (
+	// CatchUpPolicySkip does not run ticks of the schedule that were missed.
+	CatchUpPolicySkip CatchUpPolicy = "skip"
+	// CatchUpPolicyRunOnce runs once for all the ticks of the schedule that were missed.
+	CatchUpPolicyRunOnce CatchUpPolicy = "runOnce"
+	// CatchUpPolicyRunAll runs once for each tick of the schedule that was missed.
+	CatchUpPolicyRunAll CatchUpPolicy = "runAll"
+)
+
+type ScheduleOptions struct {
+	// CatchUp is what to do about ticks of the schedule that were missed, such as while the server was down.
+	// The default is "runOnce".
+	CatchUp CatchUpPolicy `json:"catchUp,omitempty"`
+	// Jitter is the maximum random delay added to each run, such as "10m", to spread out runs that are
+	// scheduled at the same time.
+	Jitter string `json:"jitter,omitempty"`
+	// Blackouts are ranges of dates on which the schedule does not run.
+	Blackouts []ScheduleBlackout `json:"blackouts,omitempty"`
+	// EndAfterRuns stops the schedule after it has run this many times.
+	EndAfterRuns int `json:"endAfterRuns,omitempty"`
+	// EndAfter stops the schedule after this date, such as "2025-12-31".
+	EndAfter string `json:"endAfter,omitempty"`
+}
+
+// ScheduleBlackout is an inclusive range of dates, such as "2025-12-24" to "2025-12-26", in the time zone of the schedule.
+type ScheduleBlackout struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
 }
 
 type CronJobList List[CronJob]
@@ -17,6 +17,13 @@ type DefaultModelAlias struct {
 type DefaultModelAliasManifest struct {
 	Alias string `json:"alias"`
 	Model string `json:"model"`
+	// Fallbacks are the models, in order, that requests are sent to when the model, or the fallback before them,
+	// fails with a rate limit, a server error, or a timeout before its response starts.
+	Fallbacks []string `json:"fallbacks,omitempty"`
+	// FallbackTimeout is how long to wait for the response of a model to start before trying the next fallback,
+	// such as "30s". The default is 60 seconds. Requests that are not streamed are given at least 10 minutes, because
+	// their response only starts once it is complete. The last model is not timed out.
+	FallbackTimeout string `json:"fallbackTimeout,omitempty"`
 }
 
 type DefaultModelAliasList List[DefaultModelAlias]
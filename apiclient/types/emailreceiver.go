@@ -13,6 +13,35 @@ type EmailReceiverManifest struct {
 	Alias          string   `json:"alias,omitempty"`
 	WorkflowName   string   `json:"workflowName"`
 	AllowedSenders []string `json:"allowedSenders,omitempty"`
+	// Attachments limits the attachments that are saved to the workspace of the triggered task run.
+	Attachments *EmailAttachmentOptions `json:"attachments,omitempty"`
+	// Reply sends the output of the triggered task run as a reply to the email. Replies to the reply continue the
+	// same task run instead of starting a new one.
+	Reply bool `json:"reply,omitempty"`
+	// SenderAuthentication is what to do with emails that are not authenticated by the domain of their sender.
+	SenderAuthentication SenderAuthenticationPolicy `json:"senderAuthentication,omitempty"`
+}
+
+type SenderAuthenticationPolicy string
+
+const (
+	// SenderAuthenticationAllow accepts emails without checking the sender. This is the default.
+	SenderAuthenticationAllow SenderAuthenticationPolicy = "allow"
+	// SenderAuthenticationTag accepts all emails and adds the results of the SPF, DKIM, and DMARC checks to the
+	// input of the task.
+	SenderAuthenticationTag SenderAuthenticationPolicy = "tag"
+	// SenderAuthenticationReject drops emails that fail DMARC alignment, in addition to tagging them.
+	SenderAuthenticationReject SenderAuthenticationPolicy = "reject"
+)
+
+type EmailAttachmentOptions struct {
+	// MaxSize is the maximum size in bytes of each attachment. Larger attachments are skipped. The default is 10 MB.
+	MaxSize int64 `json:"maxSize,omitempty"`
+	// AllowedTypes are the content types of the attachments that are saved, such as "application/pdf" or "image/*".
+	// If empty, all types are saved.
+	AllowedTypes []string `json:"allowedTypes,omitempty"`
+	// Disabled skips all attachments.
+	Disabled bool `json:"disabled,omitempty"`
 }
 
 type EmailReceiverList List[EmailReceiver]
@@ -7,12 +7,34 @@ type Model struct {
 }
 
 type ModelManifest struct {
-	Name          string     `json:"name,omitempty"`
-	TargetModel   string     `json:"targetModel,omitempty"`
-	ModelProvider string     `json:"modelProvider,omitempty"`
-	Alias         string     `json:"alias,omitempty"`
-	Active        bool       `json:"active"`
-	Usage         ModelUsage `json:"usage"`
+	Name          string        `json:"name,omitempty"`
+	TargetModel   string        `json:"targetModel,omitempty"`
+	ModelProvider string        `json:"modelProvider,omitempty"`
+	Alias         string        `json:"alias,omitempty"`
+	Active        bool          `json:"active"`
+	Usage         ModelUsage    `json:"usage"`
+	Pricing       *ModelPricing `json:"pricing,omitempty"`
+	// ResponseCache caches the responses of the model in the LLM proxy, so that identical requests are not sent to
+	// the model provider again.
+	ResponseCache *ModelResponseCache `json:"responseCache,omitempty"`
+}
+
+// ModelResponseCache configures the caching of responses of a model. Only responses to chat completion requests with
+// a temperature of 0 are cached.
+type ModelResponseCache struct {
+	Enabled bool `json:"enabled"`
+	// TTL is how long a response is cached, as a duration such as "1h". It is 24 hours if it is not set.
+	TTL string `json:"ttl,omitempty"`
+}
+
+// ModelPricing is the price of a model in dollars per million tokens. It is used to compute the cost of the LLM usage
+// of the model.
+type ModelPricing struct {
+	InputPerMillion  float64 `json:"inputPerMillion,omitempty"`
+	OutputPerMillion float64 `json:"outputPerMillion,omitempty"`
+	// CachedInputPerMillion is the price of prompt tokens that are read from the cache of the model provider. The
+	// input price is used if it is not set.
+	CachedInputPerMillion float64 `json:"cachedInputPerMillion,omitempty"`
 }
 
 type ModelList List[Model]
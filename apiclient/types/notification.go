@@ -0,0 +1,86 @@
+package types
+
+// NotificationSink is a destination that is notified when runs of tasks finish or wait for approval. Exactly one of
+// Slack, HTTP, or Email must be set.
+type NotificationSink struct {
+	// Name identifies the sink in the delivery log and must be unique among the sinks of a task or project.
+	Name string `json:"name"`
+	// Events are the events that are sent to the sink. The default is all events.
+	Events []NotificationEvent `json:"events,omitempty"`
+	Slack  *NotificationSlack  `json:"slack,omitempty"`
+	HTTP   *NotificationHTTP   `json:"http,omitempty"`
+	Email  *NotificationEmail  `json:"email,omitempty"`
+}
+
+type NotificationSlack struct {
+	// WebhookURL is the URL of a Slack incoming webhook. The URL is a secret, so it is not returned by the API; leave it
+	// empty when updating to keep the existing URL.
+	WebhookURL string `json:"webhookURL"`
+}
+
+type NotificationHTTP struct {
+	// URL receives a POST of a NotificationPayload as JSON.
+	URL string `json:"url"`
+	// Secret signs the body of each request. The signature is the hex-encoded HMAC-SHA256 of the body with a
+	// "sha256=" prefix in the X-Obot-Signature header. The secret is not returned by the API; leave it empty when
+	// updating to keep the existing secret.
+	Secret string `json:"secret,omitempty"`
+}
+
+type NotificationEmail struct {
+	// To are the addresses that the notification is sent to. Email requires an SMTP relay to be configured.
+	To []string `json:"to"`
+}
+
+type NotificationEvent string
+
+const (
+	NotificationEventComplete       NotificationEvent = "complete"
+	NotificationEventError          NotificationEvent = "error"
+	NotificationEventApprovalNeeded NotificationEvent = "approvalNeeded"
+)
+
+// NotificationPayload is the body of the requests to HTTP sinks.
+type NotificationPayload struct {
+	Event     NotificationEvent `json:"event"`
+	ProjectID string            `json:"projectID"`
+	TaskID    string            `json:"taskID"`
+	TaskName  string            `json:"taskName,omitempty"`
+	RunID     string            `json:"runID"`
+	State     WorkflowState     `json:"state"`
+	Output    string            `json:"output,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	// StepID and Message are the step that waits for approval and its approval message.
+	StepID  string `json:"stepID,omitempty"`
+	Message string `json:"message,omitempty"`
+	Time    Time   `json:"time"`
+}
+
+type NotificationDelivery struct {
+	Metadata
+	SinkName string            `json:"sinkName"`
+	SinkType string            `json:"sinkType"`
+	Event    NotificationEvent `json:"event"`
+	TaskID   string            `json:"taskID"`
+	RunID    string            `json:"runID"`
+	// State is pending while the notification is being sent or retried, and then delivered or failed.
+	State    NotificationDeliveryState `json:"state"`
+	Attempts []NotificationAttempt     `json:"attempts,omitempty"`
+}
+
+type NotificationDeliveryState string
+
+const (
+	NotificationDeliveryStatePending   NotificationDeliveryState = "pending"
+	NotificationDeliveryStateDelivered NotificationDeliveryState = "delivered"
+	NotificationDeliveryStateFailed    NotificationDeliveryState = "failed"
+)
+
+type NotificationAttempt struct {
+	Time Time `json:"time"`
+	// StatusCode is the HTTP status of the response, for Slack and HTTP sinks.
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type NotificationDeliveryList List[NotificationDelivery]
@@ -19,35 +19,130 @@ type TaskManifest struct {
 	Email          *TaskEmail          `json:"email"`
 	OnDemand       *TaskOnDemand       `json:"onDemand"`
 	OnSlackMessage *TaskOnSlackMessage `json:"onSlackMessage"`
+	// OnTaskCompletion triggers the task when a run of another task in the same project is done.
+	OnTaskCompletion *TaskOnTaskCompletion `json:"onTaskCompletion,omitempty"`
+	Concurrency      *TaskConcurrency      `json:"concurrency,omitempty"`
+	// Notifications are the sinks that are notified of the runs of the task.
+	Notifications []NotificationSink `json:"notifications,omitempty"`
+}
+
+type TaskOnTaskCompletion struct {
+	// TaskID is the ID of the task whose runs trigger this task.
+	TaskID string `json:"taskID"`
+	// States are the states of the runs that trigger this task, "Complete" and/or "Error". The default is both.
+	States []WorkflowState `json:"states,omitempty"`
+	// PassOutput adds the output or error of the run to the input of this task.
+	PassOutput bool `json:"passOutput,omitempty"`
+}
+
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow starts triggered runs even if other runs of the task are running.
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "allow"
+	// ConcurrencyPolicyForbid skips triggered runs while another run of the task is running.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "forbid"
+	// ConcurrencyPolicyReplace aborts the other runs of the task when a triggered run starts.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "replace"
+	// ConcurrencyPolicyQueue starts triggered runs one at a time, in the order they were triggered.
+	ConcurrencyPolicyQueue ConcurrencyPolicy = "queue"
+)
+
+// TaskConcurrency controls what happens when a schedule, webhook, or email triggers a task while another run of the
+// task is running.
+type TaskConcurrency struct {
+	// Policy is one of "allow", "forbid", "replace", or "queue". The default is "allow".
+	Policy ConcurrencyPolicy `json:"policy,omitempty"`
+	// MaxQueueDepth is the maximum number of runs that wait with the queue policy. Runs that are triggered
+	// when the queue is full are skipped. If unset, the queue is not limited.
+	MaxQueueDepth int `json:"maxQueueDepth,omitempty"`
 }
 
 type TaskOnSlackMessage struct {
+	// Events are the kinds of Slack events that run the task. The default is mentions of the app only.
+	Events []SlackEventType `json:"events,omitempty"`
+	// Channels are the IDs of the channels whose mentions, messages, and reactions run the task. If unset, all
+	// channels that the app is in are included.
+	Channels []string `json:"channels,omitempty"`
+	// Reactions are the names of the emoji, such as "eyes", whose reactions run the task. If unset, all reactions
+	// run the task.
+	Reactions []string `json:"reactions,omitempty"`
+	// Commands are the slash commands, optionally followed by the first words of their text, such as
+	// "/obot summarize", that run the task. If unset, all slash commands of the app run the task.
+	Commands []string `json:"commands,omitempty"`
+	// ActionIDs are the action IDs of the buttons and other interactive elements whose interactions run the task.
+	// If unset, all interactions run the task.
+	ActionIDs []string `json:"actionIDs,omitempty"`
 }
 
+type SlackEventType string
+
+const (
+	SlackEventTypeMention        SlackEventType = "mention"
+	SlackEventTypeDirectMessage  SlackEventType = "directMessage"
+	SlackEventTypeChannelMessage SlackEventType = "channelMessage"
+	SlackEventTypeReaction       SlackEventType = "reaction"
+	SlackEventTypeCommand        SlackEventType = "command"
+	SlackEventTypeInteraction    SlackEventType = "interaction"
+)
+
 type TaskOnDemand struct {
 	Params map[string]string `json:"params,omitempty"`
 }
 
 type TaskWebhook struct {
+	// Filter, Params, DeliveryIDHeader, Synchronous, and SynchronousTimeout are the same as those of a webhook.
+	Filter             string            `json:"filter,omitempty"`
+	Params             map[string]string `json:"params,omitempty"`
+	DeliveryIDHeader   string            `json:"deliveryIDHeader,omitempty"`
+	Synchronous        bool              `json:"synchronous,omitempty"`
+	SynchronousTimeout string            `json:"synchronousTimeout,omitempty"`
 }
 
 type TaskEmail struct {
+	Attachments *EmailAttachmentOptions `json:"attachments,omitempty"`
+	// Reply sends the output of each run as a reply to the email that triggered it.
+	Reply bool `json:"reply,omitempty"`
+	// SenderAuthentication is "allow", "tag", or "reject". The default is "allow".
+	SenderAuthentication SenderAuthenticationPolicy `json:"senderAuthentication,omitempty"`
 }
 
 type Schedule struct {
 	// Valid values are: "hourly", "daily", "weekly", "monthly"
-	Interval string `json:"interval"`
-	Hour     int    `json:"hour"`
-	Minute   int    `json:"minute"`
-	Day      int    `json:"day"`
-	Weekday  int    `json:"weekday"`
-	TimeZone string `json:"timezone"`
+	Interval        string `json:"interval"`
+	Hour            int    `json:"hour"`
+	Minute          int    `json:"minute"`
+	Day             int    `json:"day"`
+	Weekday         int    `json:"weekday"`
+	TimeZone        string `json:"timezone"`
+	ScheduleOptions `json:",inline"`
 }
 
 type TaskStep struct {
-	ID   string   `json:"id,omitempty"`
-	Step string   `json:"step,omitempty"`
-	Loop []string `json:"loop,omitempty"`
+	ID     string      `json:"id,omitempty"`
+	Step   string      `json:"step,omitempty"`
+	Loop   []string    `json:"loop,omitempty"`
+	If     *StepIf     `json:"if,omitempty"`
+	Switch *StepSwitch `json:"switch,omitempty"`
+	// Parallel steps run at the same time. Step, if set, is used as the instructions for joining their outputs.
+	Parallel []Step `json:"parallel,omitempty"`
+	// OutputSchema is a JSON Schema, as a JSON string, that the output of the step must match. The model is asked
+	// to respond with JSON that matches it and is asked to correct its response if it does not.
+	OutputSchema string `json:"outputSchema,omitempty"`
+	// Approval pauses the task until a user approves or rejects it.
+	Approval *StepApproval `json:"approval,omitempty"`
+	// Task runs another task in the same project and waits for its output. Step, if set, is used as the
+	// instructions for using its output.
+	Task *StepTask `json:"task,omitempty"`
+	// Concurrency is the maximum number of loop elements or parallel steps that run at the same time.
+	// Loops run one element at a time by default and parallel steps all run at once by default.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Retries is the number of times the step is run again after it fails.
+	Retries int `json:"retries,omitempty"`
+	// Backoff is how long to wait before the first retry, such as "30s". The wait doubles for each retry after that.
+	Backoff string `json:"backoff,omitempty"`
+	// Timeout is how long each attempt of the step can run before it fails, such as "5m". The default is 10 minutes.
+	Timeout string `json:"timeout,omitempty"`
 }
 
 type TaskRun struct {
@@ -60,6 +155,43 @@ type TaskRun struct {
 	StartTime *Time        `json:"startTime,omitempty"`
 	EndTime   *Time        `json:"endTime,omitempty"`
 	Error     string       `json:"error,omitempty"`
+	// ReplayOf is the ID of the run that this run replays.
+	ReplayOf string `json:"replayOf,omitempty"`
 }
 
 type TaskRunList List[TaskRun]
+
+// TaskRunReplayRequest is the body used to replay a run. Unset fields are copied from the run being replayed.
+type TaskRunReplayRequest struct {
+	Input *string       `json:"input,omitempty"`
+	Task  *TaskManifest `json:"task,omitempty"`
+}
+
+type TaskRunDiff struct {
+	RunID        string            `json:"runID,omitempty"`
+	OtherRunID   string            `json:"otherRunID,omitempty"`
+	InputChanged bool              `json:"inputChanged,omitempty"`
+	TaskChanged  bool              `json:"taskChanged,omitempty"`
+	Steps        []TaskRunStepDiff `json:"steps,omitempty"`
+}
+
+type TaskRunStepDiff struct {
+	StepID string `json:"stepID,omitempty"`
+	// Changed is true when the step, state, output, error, or tool calls of the step differ between the runs.
+	// Differences in token usage alone do not count as a change.
+	Changed bool `json:"changed,omitempty"`
+	// Run is the result of the step in the run, or nil if the step did not run.
+	Run *TaskRunStepResult `json:"run,omitempty"`
+	// Other is the result of the step in the other run, or nil if the step did not run.
+	Other *TaskRunStepResult `json:"other,omitempty"`
+}
+
+type TaskRunStepResult struct {
+	Step       string        `json:"step,omitempty"`
+	State      WorkflowState `json:"state,omitempty"`
+	Output     string        `json:"output,omitempty"`
+	Error      string        `json:"error,omitempty"`
+	Attempts   int           `json:"attempts,omitempty"`
+	ToolCalls  []string      `json:"toolCalls,omitempty"`
+	TokenUsage TokenUsage    `json:"tokenUsage"`
+}
@@ -0,0 +1,19 @@
+package types
+
+type TeamsReceiver struct {
+	Metadata
+	TeamsReceiverManifest
+
+	AppPassword string `json:"appPassword,omitempty"`
+}
+
+// TeamsReceiverManifest defines the configuration for a Microsoft Teams receiver
+type TeamsReceiverManifest struct {
+	// AppID is the Microsoft App ID of the Azure Bot. Activities are only accepted if they were issued for this app.
+	AppID string `json:"appId,omitempty"`
+	// TenantID is the Microsoft Entra tenant of a single-tenant bot. If set, only activities from this tenant are
+	// accepted and the bot gets its tokens from this tenant.
+	TenantID string `json:"tenantId,omitempty"`
+}
+
+type TeamsReceiverList List[TeamsReceiver]
@@ -55,4 +55,6 @@ type ThreadManifest struct {
 	Tools       []string `json:"tools,omitempty"`
 	Prompt      string   `json:"prompt"`
 	SharedTasks []string `json:"sharedTasks,omitempty"`
+	// Notifications are the sinks that are notified of the runs of all tasks of the project.
+	Notifications []NotificationSink `json:"notifications,omitempty"`
 }
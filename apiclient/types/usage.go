@@ -1,14 +1,29 @@
 package types
 
 type TokenUsage struct {
-	UserID           string `json:"userID,omitempty"`
-	RunName          string `json:"runName,omitempty"`
-	PromptTokens     int    `json:"promptTokens"`
-	CompletionTokens int    `json:"completionTokens"`
-	TotalTokens      int    `json:"totalTokens"`
-	Date             Time   `json:"date,omitzero"`
+	UserID             string  `json:"userID,omitempty"`
+	RunName            string  `json:"runName,omitempty"`
+	ProjectID          string  `json:"projectID,omitempty"`
+	TaskID             string  `json:"taskID,omitempty"`
+	Model              string  `json:"model,omitempty"`
+	PromptTokens       int     `json:"promptTokens"`
+	CachedPromptTokens int     `json:"cachedPromptTokens"`
+	CompletionTokens   int     `json:"completionTokens"`
+	TotalTokens        int     `json:"totalTokens"`
+	Cost               float64 `json:"cost"`
+	Date               Time    `json:"date,omitzero"`
 }
 
+// TokenUsageGroupBy is what the token usage is summed by.
+type TokenUsageGroupBy string
+
+const (
+	TokenUsageGroupByUser    TokenUsageGroupBy = "user"
+	TokenUsageGroupByProject TokenUsageGroupBy = "project"
+	TokenUsageGroupByTask    TokenUsageGroupBy = "task"
+	TokenUsageGroupByModel   TokenUsageGroupBy = "model"
+)
+
 type TokenUsageList List[TokenUsage]
 
 type RemainingTokenUsage struct {
@@ -16,6 +16,87 @@ type WebhookManifest struct {
 	Headers          []string `json:"headers"`
 	Secret           string   `json:"secret"`
 	ValidationHeader string   `json:"validationHeader"`
+	// SignaturePreset is the format of the signature of the requests, for providers that sign requests with the
+	// secret. If ValidationHeader is empty, the header of the provider is used. If SignaturePreset is empty, the
+	// header must contain a hex-encoded HMAC-SHA256 of the body.
+	SignaturePreset WebhookSignaturePreset `json:"signaturePreset,omitempty"`
+	// SignatureTolerance is how old the timestamp of a signature can be, such as "5m", for the presets that sign
+	// a timestamp. Older requests are rejected so that they cannot be replayed. The default is 5 minutes.
+	SignatureTolerance string `json:"signatureTolerance,omitempty"`
+	// Filter is an expression, such as `payload.action == "opened"`, that must be true for a request to run the
+	// workflow. Requests that do not match are accepted without running it.
+	Filter string `json:"filter,omitempty"`
+	// Params maps the parameters of the workflow to expressions, such as "payload.pull_request.title". If set,
+	// the parameters are the input of the workflow instead of the payload and headers of the request.
+	Params map[string]string `json:"params,omitempty"`
+	// DeliveryIDHeader is the header, such as X-GitHub-Delivery, with the unique ID of each delivery. Deliveries
+	// with the ID of an earlier delivery are accepted without running the workflow again.
+	DeliveryIDHeader string `json:"deliveryIDHeader,omitempty"`
+	// Synchronous makes requests wait for the run of the workflow to finish and respond with its output. If the run
+	// does not finish within SynchronousTimeout, the response is a WebhookPendingResponse with status 202.
+	Synchronous bool `json:"synchronous,omitempty"`
+	// SynchronousTimeout is how long a synchronous request waits, such as "30s". The default is 30 seconds and the
+	// maximum is 5 minutes.
+	SynchronousTimeout string `json:"synchronousTimeout,omitempty"`
 }
 
+type WebhookSignaturePreset string
+
+const (
+	// WebhookSignatureGitHub is a hex HMAC-SHA256 of the body with a "sha256=" prefix in X-Hub-Signature-256.
+	WebhookSignatureGitHub WebhookSignaturePreset = "github"
+	// WebhookSignatureStripe is a hex HMAC-SHA256 of the timestamp and the body in Stripe-Signature, in the form
+	// "t=<timestamp>,v1=<signature>".
+	WebhookSignatureStripe WebhookSignaturePreset = "stripe"
+	// WebhookSignatureSlack is a hex HMAC-SHA256 of "v0:<timestamp>:<body>" with a "v0=" prefix in
+	// X-Slack-Signature, with the timestamp in X-Slack-Request-Timestamp.
+	WebhookSignatureSlack WebhookSignaturePreset = "slack"
+	// WebhookSignatureShopify is a base64 HMAC-SHA256 of the body in X-Shopify-Hmac-Sha256.
+	WebhookSignatureShopify WebhookSignaturePreset = "shopify"
+	// WebhookSignatureHMACSHA1, WebhookSignatureHMACSHA256, and WebhookSignatureHMACSHA512 are a hex or base64
+	// HMAC of the body, optionally with a prefix such as "sha1=", in ValidationHeader.
+	WebhookSignatureHMACSHA1   WebhookSignaturePreset = "hmac-sha1"
+	WebhookSignatureHMACSHA256 WebhookSignaturePreset = "hmac-sha256"
+	WebhookSignatureHMACSHA512 WebhookSignaturePreset = "hmac-sha512"
+)
+
 type WebhookList List[Webhook]
+
+type WebhookDelivery struct {
+	Metadata
+	WebhookID string `json:"webhookID"`
+	// DeliveryID is the value of the DeliveryIDHeader of the webhook in the request.
+	DeliveryID string            `json:"deliveryID,omitempty"`
+	ReceivedAt Time              `json:"receivedAt"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	BodySize   int               `json:"bodySize"`
+	BodyHash   string            `json:"bodyHash"`
+	// BodyTruncated is whether the body was too large to be saved in full, so that the delivery cannot be redelivered.
+	BodyTruncated bool                  `json:"bodyTruncated,omitempty"`
+	Result        WebhookDeliveryResult `json:"result"`
+	// WorkflowExecutionIDs are the executions that were started for the delivery, the first when it was received and
+	// the rest when it was redelivered.
+	WorkflowExecutionIDs []string `json:"workflowExecutionIDs,omitempty"`
+	// Duplicates is the number of times the delivery was received again with the same delivery ID.
+	Duplicates int `json:"duplicates,omitempty"`
+}
+
+type WebhookDeliveryResult string
+
+const (
+	WebhookDeliveryResultAccepted         WebhookDeliveryResult = "accepted"
+	WebhookDeliveryResultFiltered         WebhookDeliveryResult = "filtered"
+	WebhookDeliveryResultInvalidSignature WebhookDeliveryResult = "invalidSignature"
+	WebhookDeliveryResultInvalidToken     WebhookDeliveryResult = "invalidToken"
+)
+
+type WebhookDeliveryList List[WebhookDelivery]
+
+// WebhookPendingResponse is the response to a request to a synchronous webhook whose run has not finished. The
+// output of the run can be retrieved from StatusURL once it finishes.
+type WebhookPendingResponse struct {
+	DeliveryID          string        `json:"deliveryID"`
+	WorkflowExecutionID string        `json:"workflowExecutionID"`
+	State               WorkflowState `json:"state"`
+	StatusURL           string        `json:"statusURL"`
+}
@@ -11,13 +11,16 @@ type Workflow struct {
 type WorkflowList List[Workflow]
 
 type WorkflowManifest struct {
-	Alias          string              `json:"alias"`
-	Steps          []Step              `json:"steps"`
-	Params         map[string]string   `json:"params,omitempty"`
-	Output         string              `json:"output"`
-	Name           string              `json:"name,omitempty"`
-	Description    string              `json:"description,omitempty"`
-	OnSlackMessage *TaskOnSlackMessage `json:"onSlackMessage,omitempty"`
+	Alias            string                `json:"alias"`
+	Steps            []Step                `json:"steps"`
+	Params           map[string]string     `json:"params,omitempty"`
+	Output           string                `json:"output"`
+	Name             string                `json:"name,omitempty"`
+	Description      string                `json:"description,omitempty"`
+	OnSlackMessage   *TaskOnSlackMessage   `json:"onSlackMessage,omitempty"`
+	OnTaskCompletion *TaskOnTaskCompletion `json:"onTaskCompletion,omitempty"`
+	Concurrency      *TaskConcurrency      `json:"concurrency,omitempty"`
+	Notifications    []NotificationSink    `json:"notifications,omitempty"`
 }
 
 type EnvVar struct {
@@ -28,9 +31,113 @@ type EnvVar struct {
 }
 
 type Step struct {
-	ID   string   `json:"id,omitempty"`
-	Step string   `json:"step,omitempty"`
-	Loop []string `json:"loop,omitempty"`
+	ID     string      `json:"id,omitempty"`
+	Step   string      `json:"step,omitempty"`
+	Loop   []string    `json:"loop,omitempty"`
+	If     *StepIf     `json:"if,omitempty"`
+	Switch *StepSwitch `json:"switch,omitempty"`
+	// Parallel steps run at the same time. Step, if set, is used as the instructions for joining their outputs.
+	Parallel []Step `json:"parallel,omitempty"`
+	// OutputSchema is a JSON Schema, as a JSON string, that the output of the step must match. The model is asked
+	// to respond with JSON that matches it and is asked to correct its response if it does not.
+	OutputSchema string `json:"outputSchema,omitempty"`
+	// Approval pauses the task until a user approves or rejects it.
+	Approval *StepApproval `json:"approval,omitempty"`
+	// Task runs another task in the same project and waits for its output. Step, if set, is used as the
+	// instructions for using its output.
+	Task *StepTask `json:"task,omitempty"`
+	// Concurrency is the maximum number of loop elements or parallel steps that run at the same time.
+	// Loops run one element at a time by default and parallel steps all run at once by default.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Retries is the number of times the step is run again after it fails.
+	Retries int `json:"retries,omitempty"`
+	// Backoff is how long to wait before the first retry, such as "30s". The wait doubles for each retry after that.
+	Backoff string `json:"backoff,omitempty"`
+	// Timeout is how long each attempt of the step can run before it fails, such as "5m". The default is 10 minutes.
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// StepIf runs Steps when the condition is true and Else otherwise. The condition is evaluated by the model
+// unless Expression is set, in which case it is evaluated against the workflow input and prior step outputs.
+type StepIf struct {
+	Condition  string `json:"condition,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	Steps      []Step `json:"steps,omitempty"`
+	Else       []Step `json:"else,omitempty"`
+}
+
+// StepSwitch runs the steps of the first case whose value matches, or Default if none match. The value is
+// chosen by the model based on Value unless Expression is set.
+type StepSwitch struct {
+	Value      string       `json:"value,omitempty"`
+	Expression string       `json:"expression,omitempty"`
+	Cases      []SwitchCase `json:"cases,omitempty"`
+	Default    []Step       `json:"default,omitempty"`
+}
+
+type SwitchCase struct {
+	Value string `json:"value,omitempty"`
+	Steps []Step `json:"steps,omitempty"`
+}
+
+type StepApproval struct {
+	// Message describes what is being approved.
+	Message string `json:"message,omitempty"`
+	// Approvers are the IDs or email addresses of the users that can approve or reject. If empty, any user with
+	// access to the task can.
+	Approvers []string `json:"approvers,omitempty"`
+	// Timeout is how long to wait for a decision, such as "24h". If unset, the task waits until a decision is made.
+	Timeout string `json:"timeout,omitempty"`
+	// DefaultAction is the decision made when the timeout is reached, either "approve" or "reject". The default is "reject".
+	DefaultAction string `json:"defaultAction,omitempty"`
+}
+
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApproved ApprovalDecision = "approved"
+	ApprovalDecisionRejected ApprovalDecision = "rejected"
+)
+
+// StepApprovalRequest is the body used to approve or reject an approval step. An empty body approves.
+type StepApprovalRequest struct {
+	Reject  bool   `json:"reject,omitempty"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type StepApprovalStatus struct {
+	StepID      string           `json:"stepID,omitempty"`
+	Message     string           `json:"message,omitempty"`
+	RequestedAt *Time            `json:"requestedAt,omitempty"`
+	Decision    ApprovalDecision `json:"decision,omitempty"`
+	DecidedBy   string           `json:"decidedBy,omitempty"`
+	DecidedAt   *Time            `json:"decidedAt,omitempty"`
+	Comment     string           `json:"comment,omitempty"`
+}
+
+type StepTask struct {
+	// ID is the ID of the task to run.
+	ID string `json:"id,omitempty"`
+	// Params maps the parameters of the task to expressions, such as "input.city" or "'London'", that are
+	// evaluated the same way as the expression of an if step.
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Branches returns all the nested steps of an if, switch, or parallel step.
+func (s Step) Branches() (result [][]Step) {
+	if s.If != nil {
+		result = append(result, s.If.Steps, s.If.Else)
+	}
+	if s.Switch != nil {
+		for _, c := range s.Switch.Cases {
+			result = append(result, c.Steps)
+		}
+		result = append(result, s.Switch.Default)
+	}
+	if len(s.Parallel) > 0 {
+		result = append(result, s.Parallel)
+	}
+	return result
 }
 
 func (s Step) Display() string {
@@ -41,6 +148,20 @@ func (s Step) Display() string {
 	if s.Step != "" {
 		preamble.WriteString(" ")
 		preamble.WriteString(oneLine(s.Step))
+	} else if s.If != nil {
+		preamble.WriteString(" if ")
+		preamble.WriteString(oneLine(firstSet(s.If.Expression, s.If.Condition)))
+	} else if s.Switch != nil {
+		preamble.WriteString(" switch ")
+		preamble.WriteString(oneLine(firstSet(s.Switch.Expression, s.Switch.Value)))
+	} else if len(s.Parallel) > 0 {
+		preamble.WriteString(" parallel")
+	} else if s.Approval != nil {
+		preamble.WriteString(" approval ")
+		preamble.WriteString(oneLine(s.Approval.Message))
+	} else if s.Task != nil {
+		preamble.WriteString(" task ")
+		preamble.WriteString(s.Task.ID)
 	}
 	return preamble.String()
 }
@@ -53,6 +174,15 @@ func oneLine(s string) string {
 	return l
 }
 
+func firstSet(s ...string) string {
+	for _, v := range s {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 func FindStep(manifest *WorkflowManifest, id string) (_ *Step, parentID string) {
 	if manifest == nil || id == "" {
 		return nil, ""
@@ -71,6 +201,11 @@ func findInSteps(parentID string, steps []Step, id string) (*Step, string) {
 		if step.ID == id {
 			return &steps[i], parentID
 		}
+		for _, branch := range step.Branches() {
+			if found, parentID := findInSteps(step.ID, branch, id); found != nil {
+				return found, parentID
+			}
+		}
 	}
 	return nil, ""
 }
@@ -417,6 +417,82 @@ func (in *AuthorizationList) DeepCopy() *AuthorizationList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Budget) DeepCopyInto(out *Budget) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.BudgetManifest.DeepCopyInto(&out.BudgetManifest)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Budget.
+func (in *Budget) DeepCopy() *Budget {
+	if in == nil {
+		return nil
+	}
+	out := new(Budget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetLimit) DeepCopyInto(out *BudgetLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetLimit.
+func (in *BudgetLimit) DeepCopy() *BudgetLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetList) DeepCopyInto(out *BudgetList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Budget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetList.
+func (in *BudgetList) DeepCopy() *BudgetList {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BudgetManifest) DeepCopyInto(out *BudgetManifest) {
+	*out = *in
+	if in.UserIDs != nil {
+		in, out := &in.UserIDs, &out.UserIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Soft = in.Soft
+	out.Hard = in.Hard
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BudgetManifest.
+func (in *BudgetManifest) DeepCopy() *BudgetManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(BudgetManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonProviderMetadata) DeepCopyInto(out *CommonProviderMetadata) {
 	*out = *in
@@ -566,8 +642,9 @@ func (in *CronJobManifest) DeepCopyInto(out *CronJobManifest) {
 	if in.TaskSchedule != nil {
 		in, out := &in.TaskSchedule, &out.TaskSchedule
 		*out = new(Schedule)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
+	in.ScheduleOptions.DeepCopyInto(&out.ScheduleOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobManifest.
@@ -583,7 +660,7 @@ func (in *CronJobManifest) DeepCopy() *CronJobManifest {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultModelAlias) DeepCopyInto(out *DefaultModelAlias) {
 	*out = *in
-	out.DefaultModelAliasManifest = in.DefaultModelAliasManifest
+	in.DefaultModelAliasManifest.DeepCopyInto(&out.DefaultModelAliasManifest)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultModelAlias.
@@ -602,7 +679,9 @@ func (in *DefaultModelAliasList) DeepCopyInto(out *DefaultModelAliasList) {
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]DefaultModelAlias, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -619,6 +698,11 @@ func (in *DefaultModelAliasList) DeepCopy() *DefaultModelAliasList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultModelAliasManifest) DeepCopyInto(out *DefaultModelAliasManifest) {
 	*out = *in
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultModelAliasManifest.
@@ -631,6 +715,26 @@ func (in *DefaultModelAliasManifest) DeepCopy() *DefaultModelAliasManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailAttachmentOptions) DeepCopyInto(out *EmailAttachmentOptions) {
+	*out = *in
+	if in.AllowedTypes != nil {
+		in, out := &in.AllowedTypes, &out.AllowedTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailAttachmentOptions.
+func (in *EmailAttachmentOptions) DeepCopy() *EmailAttachmentOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailAttachmentOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmailReceiver) DeepCopyInto(out *EmailReceiver) {
 	*out = *in
@@ -683,6 +787,11 @@ func (in *EmailReceiverManifest) DeepCopyInto(out *EmailReceiverManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = new(EmailAttachmentOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailReceiverManifest.
@@ -1268,7 +1377,7 @@ func (in *Metadata) DeepCopy() *Metadata {
 func (in *Model) DeepCopyInto(out *Model) {
 	*out = *in
 	in.Metadata.DeepCopyInto(&out.Metadata)
-	out.ModelManifest = in.ModelManifest
+	in.ModelManifest.DeepCopyInto(&out.ModelManifest)
 	in.ModelStatus.DeepCopyInto(&out.ModelStatus)
 }
 
@@ -1307,6 +1416,16 @@ func (in *ModelList) DeepCopy() *ModelList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelManifest) DeepCopyInto(out *ModelManifest) {
 	*out = *in
+	if in.Pricing != nil {
+		in, out := &in.Pricing, &out.Pricing
+		*out = new(ModelPricing)
+		**out = **in
+	}
+	if in.ResponseCache != nil {
+		in, out := &in.ResponseCache, &out.ResponseCache
+		*out = new(ModelResponseCache)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelManifest.
@@ -1319,6 +1438,21 @@ func (in *ModelManifest) DeepCopy() *ModelManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelPricing) DeepCopyInto(out *ModelPricing) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelPricing.
+func (in *ModelPricing) DeepCopy() *ModelPricing {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelPricing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelProvider) DeepCopyInto(out *ModelProvider) {
 	*out = *in
@@ -1395,6 +1529,21 @@ func (in *ModelProviderStatus) DeepCopy() *ModelProviderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelResponseCache) DeepCopyInto(out *ModelResponseCache) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelResponseCache.
+func (in *ModelResponseCache) DeepCopy() *ModelResponseCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelResponseCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	*out = *in
@@ -1415,6 +1564,168 @@ func (in *ModelStatus) DeepCopy() *ModelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationAttempt) DeepCopyInto(out *NotificationAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationAttempt.
+func (in *NotificationAttempt) DeepCopy() *NotificationAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]NotificationAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryList) DeepCopyInto(out *NotificationDeliveryList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryList.
+func (in *NotificationDeliveryList) DeepCopy() *NotificationDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationEmail) DeepCopyInto(out *NotificationEmail) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationEmail.
+func (in *NotificationEmail) DeepCopy() *NotificationEmail {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationEmail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationHTTP) DeepCopyInto(out *NotificationHTTP) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationHTTP.
+func (in *NotificationHTTP) DeepCopy() *NotificationHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPayload) DeepCopyInto(out *NotificationPayload) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPayload.
+func (in *NotificationPayload) DeepCopy() *NotificationPayload {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPayload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSink) DeepCopyInto(out *NotificationSink) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(NotificationSlack)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(NotificationHTTP)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(NotificationEmail)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSink.
+func (in *NotificationSink) DeepCopy() *NotificationSink {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationSlack) DeepCopyInto(out *NotificationSlack) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationSlack.
+func (in *NotificationSlack) DeepCopy() *NotificationSlack {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationSlack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NotionConfig) DeepCopyInto(out *NotionConfig) {
 	*out = *in
@@ -1911,6 +2222,23 @@ func (in *ProviderConfigurationParameter) DeepCopy() *ProviderConfigurationParam
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemainingBudget) DeepCopyInto(out *RemainingBudget) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemainingBudget.
+func (in *RemainingBudget) DeepCopy() *RemainingBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RemainingBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemainingTokenUsage) DeepCopyInto(out *RemainingTokenUsage) {
 	*out = *in
@@ -1987,6 +2315,7 @@ func (in *RunList) DeepCopy() *RunList {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
+	in.ScheduleOptions.DeepCopyInto(&out.ScheduleOptions)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Schedule.
@@ -1999,6 +2328,41 @@ func (in *Schedule) DeepCopy() *Schedule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleBlackout) DeepCopyInto(out *ScheduleBlackout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleBlackout.
+func (in *ScheduleBlackout) DeepCopy() *ScheduleBlackout {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleBlackout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleOptions) DeepCopyInto(out *ScheduleOptions) {
+	*out = *in
+	if in.Blackouts != nil {
+		in, out := &in.Blackouts, &out.Blackouts
+		*out = make([]ScheduleBlackout, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleOptions.
+func (in *ScheduleOptions) DeepCopy() *ScheduleOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlackReceiver) DeepCopyInto(out *SlackReceiver) {
 	*out = *in
@@ -2061,10 +2425,37 @@ func (in *Step) DeepCopyInto(out *Step) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
-func (in *Step) DeepCopy() *Step {
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(StepIf)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = new(StepSwitch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(StepApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(StepTask)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
 	if in == nil {
 		return nil
 	}
@@ -2073,6 +2464,144 @@ func (in *Step) DeepCopy() *Step {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApproval) DeepCopyInto(out *StepApproval) {
+	*out = *in
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApproval.
+func (in *StepApproval) DeepCopy() *StepApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApprovalRequest) DeepCopyInto(out *StepApprovalRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApprovalRequest.
+func (in *StepApprovalRequest) DeepCopy() *StepApprovalRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApprovalRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepApprovalStatus) DeepCopyInto(out *StepApprovalStatus) {
+	*out = *in
+	if in.RequestedAt != nil {
+		in, out := &in.RequestedAt, &out.RequestedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.DecidedAt != nil {
+		in, out := &in.DecidedAt, &out.DecidedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepApprovalStatus.
+func (in *StepApprovalStatus) DeepCopy() *StepApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StepApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepIf) DeepCopyInto(out *StepIf) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Else != nil {
+		in, out := &in.Else, &out.Else
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepIf.
+func (in *StepIf) DeepCopy() *StepIf {
+	if in == nil {
+		return nil
+	}
+	out := new(StepIf)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepSwitch) DeepCopyInto(out *StepSwitch) {
+	*out = *in
+	if in.Cases != nil {
+		in, out := &in.Cases, &out.Cases
+		*out = make([]SwitchCase, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepSwitch.
+func (in *StepSwitch) DeepCopy() *StepSwitch {
+	if in == nil {
+		return nil
+	}
+	out := new(StepSwitch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepTask) DeepCopyInto(out *StepTask) {
+	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepTask.
+func (in *StepTask) DeepCopy() *StepTask {
+	if in == nil {
+		return nil
+	}
+	out := new(StepTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StepTemplateInvoke) DeepCopyInto(out *StepTemplateInvoke) {
 	*out = *in
@@ -2095,6 +2624,28 @@ func (in *StepTemplateInvoke) DeepCopy() *StepTemplateInvoke {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchCase) DeepCopyInto(out *SwitchCase) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchCase.
+func (in *SwitchCase) DeepCopy() *SwitchCase {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchCase)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Table) DeepCopyInto(out *Table) {
 	*out = *in
@@ -2147,9 +2698,29 @@ func (in *Task) DeepCopy() *Task {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskConcurrency) DeepCopyInto(out *TaskConcurrency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskConcurrency.
+func (in *TaskConcurrency) DeepCopy() *TaskConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskEmail) DeepCopyInto(out *TaskEmail) {
 	*out = *in
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = new(EmailAttachmentOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskEmail.
@@ -2197,17 +2768,17 @@ func (in *TaskManifest) DeepCopyInto(out *TaskManifest) {
 	if in.Schedule != nil {
 		in, out := &in.Schedule, &out.Schedule
 		*out = new(Schedule)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Webhook != nil {
 		in, out := &in.Webhook, &out.Webhook
 		*out = new(TaskWebhook)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Email != nil {
 		in, out := &in.Email, &out.Email
 		*out = new(TaskEmail)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.OnDemand != nil {
 		in, out := &in.OnDemand, &out.OnDemand
@@ -2217,8 +2788,25 @@ func (in *TaskManifest) DeepCopyInto(out *TaskManifest) {
 	if in.OnSlackMessage != nil {
 		in, out := &in.OnSlackMessage, &out.OnSlackMessage
 		*out = new(TaskOnSlackMessage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnTaskCompletion != nil {
+		in, out := &in.OnTaskCompletion, &out.OnTaskCompletion
+		*out = new(TaskOnTaskCompletion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(TaskConcurrency)
 		**out = **in
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskManifest.
@@ -2256,6 +2844,31 @@ func (in *TaskOnDemand) DeepCopy() *TaskOnDemand {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskOnSlackMessage) DeepCopyInto(out *TaskOnSlackMessage) {
 	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]SlackEventType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Reactions != nil {
+		in, out := &in.Reactions, &out.Reactions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Commands != nil {
+		in, out := &in.Commands, &out.Commands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActionIDs != nil {
+		in, out := &in.ActionIDs, &out.ActionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOnSlackMessage.
@@ -2268,6 +2881,26 @@ func (in *TaskOnSlackMessage) DeepCopy() *TaskOnSlackMessage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskOnTaskCompletion) DeepCopyInto(out *TaskOnTaskCompletion) {
+	*out = *in
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]WorkflowState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOnTaskCompletion.
+func (in *TaskOnTaskCompletion) DeepCopy() *TaskOnTaskCompletion {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskOnTaskCompletion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskRun) DeepCopyInto(out *TaskRun) {
 	*out = *in
@@ -2293,6 +2926,28 @@ func (in *TaskRun) DeepCopy() *TaskRun {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunDiff) DeepCopyInto(out *TaskRunDiff) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]TaskRunStepDiff, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunDiff.
+func (in *TaskRunDiff) DeepCopy() *TaskRunDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskRunList) DeepCopyInto(out *TaskRunList) {
 	*out = *in
@@ -2315,6 +2970,77 @@ func (in *TaskRunList) DeepCopy() *TaskRunList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunReplayRequest) DeepCopyInto(out *TaskRunReplayRequest) {
+	*out = *in
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(string)
+		**out = **in
+	}
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(TaskManifest)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunReplayRequest.
+func (in *TaskRunReplayRequest) DeepCopy() *TaskRunReplayRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunReplayRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunStepDiff) DeepCopyInto(out *TaskRunStepDiff) {
+	*out = *in
+	if in.Run != nil {
+		in, out := &in.Run, &out.Run
+		*out = new(TaskRunStepResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Other != nil {
+		in, out := &in.Other, &out.Other
+		*out = new(TaskRunStepResult)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunStepDiff.
+func (in *TaskRunStepDiff) DeepCopy() *TaskRunStepDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStepDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskRunStepResult) DeepCopyInto(out *TaskRunStepResult) {
+	*out = *in
+	if in.ToolCalls != nil {
+		in, out := &in.ToolCalls, &out.ToolCalls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TokenUsage.DeepCopyInto(&out.TokenUsage)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskRunStepResult.
+func (in *TaskRunStepResult) DeepCopy() *TaskRunStepResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskRunStepResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskStep) DeepCopyInto(out *TaskStep) {
 	*out = *in
@@ -2323,6 +3049,33 @@ func (in *TaskStep) DeepCopyInto(out *TaskStep) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.If != nil {
+		in, out := &in.If, &out.If
+		*out = new(StepIf)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Switch != nil {
+		in, out := &in.Switch, &out.Switch
+		*out = new(StepSwitch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(StepApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(StepTask)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskStep.
@@ -2338,6 +3091,13 @@ func (in *TaskStep) DeepCopy() *TaskStep {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskWebhook) DeepCopyInto(out *TaskWebhook) {
 	*out = *in
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskWebhook.
@@ -2350,6 +3110,60 @@ func (in *TaskWebhook) DeepCopy() *TaskWebhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiver) DeepCopyInto(out *TeamsReceiver) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	out.TeamsReceiverManifest = in.TeamsReceiverManifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiver.
+func (in *TeamsReceiver) DeepCopy() *TeamsReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiverList) DeepCopyInto(out *TeamsReceiverList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TeamsReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiverList.
+func (in *TeamsReceiverList) DeepCopy() *TeamsReceiverList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiverManifest) DeepCopyInto(out *TeamsReceiverManifest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiverManifest.
+func (in *TeamsReceiverManifest) DeepCopy() *TeamsReceiverManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiverManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateAuthorization) DeepCopyInto(out *TemplateAuthorization) {
 	*out = *in
@@ -2510,6 +3324,13 @@ func (in *ThreadManifest) DeepCopyInto(out *ThreadManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadManifest.
@@ -2817,6 +3638,57 @@ func (in *Webhook) DeepCopy() *Webhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDelivery) DeepCopyInto(out *WebhookDelivery) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.ReceivedAt.DeepCopyInto(&out.ReceivedAt)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.WorkflowExecutionIDs != nil {
+		in, out := &in.WorkflowExecutionIDs, &out.WorkflowExecutionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDelivery.
+func (in *WebhookDelivery) DeepCopy() *WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryList) DeepCopyInto(out *WebhookDeliveryList) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebhookDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryList.
+func (in *WebhookDeliveryList) DeepCopy() *WebhookDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookList) DeepCopyInto(out *WebhookList) {
 	*out = *in
@@ -2847,6 +3719,13 @@ func (in *WebhookManifest) DeepCopyInto(out *WebhookManifest) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookManifest.
@@ -2859,6 +3738,21 @@ func (in *WebhookManifest) DeepCopy() *WebhookManifest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookPendingResponse) DeepCopyInto(out *WebhookPendingResponse) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookPendingResponse.
+func (in *WebhookPendingResponse) DeepCopy() *WebhookPendingResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookPendingResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebsiteCrawlingConfig) DeepCopyInto(out *WebsiteCrawlingConfig) {
 	*out = *in
@@ -3017,8 +3911,25 @@ func (in *WorkflowManifest) DeepCopyInto(out *WorkflowManifest) {
 	if in.OnSlackMessage != nil {
 		in, out := &in.OnSlackMessage, &out.OnSlackMessage
 		*out = new(TaskOnSlackMessage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnTaskCompletion != nil {
+		in, out := &in.OnTaskCompletion, &out.OnTaskCompletion
+		*out = new(TaskOnTaskCompletion)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(TaskConcurrency)
 		**out = **in
 	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]NotificationSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowManifest.
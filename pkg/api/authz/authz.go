@@ -29,6 +29,7 @@ var staticRules = map[string][]string{
 		"/oauth2/",
 
 		"POST /api/webhooks/{namespace}/{id}",
+		"GET /api/webhooks/{namespace}/{id}/deliveries/{delivery_id}/result",
 		"GET /api/token-request/{id}",
 		"POST /api/token-request",
 		"GET /api/token-request/{id}/{service}",
@@ -53,6 +54,9 @@ var staticRules = map[string][]string{
 		"GET /api/auth-providers/{id}",
 
 		"POST /api/slack/events",
+		"POST /api/slack/commands",
+		"POST /api/slack/interactions",
+		"POST /api/teams/messages",
 
 		// Allow public access to read display info for featured Obots
 		// This is used in the unauthenticated landing page
@@ -49,6 +49,7 @@ var apiResources = []string{
 	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/mcpservers/{mcpserver_id}",
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/mcpservers/{mcpserver_id}",
 	"PUT    /api/assistants/{assistant_id}/projects/{project_id}/mcpservers/{mcpserver_id}",
+	"GET    /api/assistants/{assistant_id}/projects/{project_id}/notifications",
 	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/share",
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/share",
 	"POST   /api/assistants/{assistant_id}/projects/{project_id}/share",
@@ -64,6 +65,7 @@ var apiResources = []string{
 	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}",
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}",
 	"PUT    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}",
+	"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/notifications",
 	"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/run",
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs",
 	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}",
@@ -78,6 +80,13 @@ var apiResources = []string{
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/files/{file...}",
 	"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/files/{file...}",
 	"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/steps/{step_id}/run",
+	"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/steps/{step_id}/approve",
+	"POST   /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/replay",
+	"GET    /api/assistants/{assistant_id}/projects/{project_id}/tasks/{task_id}/runs/{run_id}/diff/{other_run_id}",
+	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/teams",
+	"GET    /api/assistants/{assistant_id}/projects/{project_id}/teams",
+	"POST   /api/assistants/{assistant_id}/projects/{project_id}/teams",
+	"PUT    /api/assistants/{assistant_id}/projects/{project_id}/teams",
 	"GET    /api/assistants/{assistant_id}/projects/{project_id}/threads",
 	"POST   /api/assistants/{assistant_id}/projects/{project_id}/threads",
 	"DELETE /api/assistants/{assistant_id}/projects/{project_id}/threads/{thread_id}",
@@ -152,6 +152,7 @@ func convertCronJob(cronJob v1.CronJob) types.CronJob {
 		LastRunStartedAt:           v1.NewTime(cronJob.Status.LastRunStartedAt),
 		LastSuccessfulRunCompleted: v1.NewTime(cronJob.Status.LastSuccessfulRunCompleted),
 		NextRunAt:                  types.NewTimeFromPointer(nextRunAt),
+		RunCount:                   cronJob.Status.RunCount,
 	}
 }
 
@@ -163,6 +164,9 @@ func parseAndValidateCronManifest(req api.Context) (*types.CronJobManifest, erro
 	if !gronx.IsValid(manifest.Schedule) {
 		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid schedule %s", manifest.Schedule))
 	}
+	if err := cronjob.ValidateScheduleOptions(manifest.ScheduleOptions); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
 
 	var workflow v1.Workflow
 	if err := req.Get(&workflow, manifest.WorkflowName); err != nil {
@@ -1,10 +1,15 @@
 package handlers
 
 import (
+	"slices"
+	"time"
+
 	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/alias"
 	"github.com/obot-platform/obot/pkg/api"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -20,6 +25,10 @@ func (d *DefaultModelAliasHandler) Create(req api.Context) error {
 		return err
 	}
 
+	if err := validateDefaultModelAlias(req, manifest); err != nil {
+		return err
+	}
+
 	dma := v1.DefaultModelAlias{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: system.DefaultModelAliasPrefix,
@@ -69,6 +78,10 @@ func (d *DefaultModelAliasHandler) Update(req api.Context) error {
 		return err
 	}
 
+	if err := validateDefaultModelAlias(req, manifest); err != nil {
+		return err
+	}
+
 	dma.Spec.Manifest = manifest
 	if err := req.Update(&dma); err != nil {
 		return err
@@ -91,3 +104,26 @@ func convertDefaultModelAlias(d v1.DefaultModelAlias) types.DefaultModelAlias {
 		DefaultModelAliasManifest: d.Spec.Manifest,
 	}
 }
+
+func validateDefaultModelAlias(req api.Context, manifest types.DefaultModelAliasManifest) error {
+	if manifest.FallbackTimeout != "" {
+		if timeout, err := time.ParseDuration(manifest.FallbackTimeout); err != nil || timeout <= 0 {
+			return types.NewErrBadRequest("invalid fallback timeout %q: must be a positive duration", manifest.FallbackTimeout)
+		}
+	}
+
+	for i, fallback := range manifest.Fallbacks {
+		if fallback == manifest.Model || slices.Contains(manifest.Fallbacks[:i], fallback) {
+			return types.NewErrBadRequest("fallback model %q is listed more than once", fallback)
+		}
+
+		var model v1.Model
+		if err := alias.Get(req.Context(), req.Storage, &model, req.Namespace(), fallback); apierrors.IsNotFound(err) {
+			return types.NewErrBadRequest("fallback model %q not found", fallback)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"fmt"
+	"mime"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/alias"
@@ -36,6 +37,14 @@ func (e *EmailReceiverHandler) Update(req api.Context) error {
 		return err
 	}
 
+	if err := validateEmailAttachmentOptions(manifest.Attachments); err != nil {
+		return err
+	}
+
+	if err := validateSenderAuthentication(manifest.SenderAuthentication); err != nil {
+		return err
+	}
+
 	er.Spec.EmailReceiverManifest = manifest
 	if err := req.Update(&er); err != nil {
 		return err
@@ -63,6 +72,14 @@ func (e *EmailReceiverHandler) Create(req api.Context) error {
 		return err
 	}
 
+	if err := validateEmailAttachmentOptions(manifest.Attachments); err != nil {
+		return err
+	}
+
+	if err := validateSenderAuthentication(manifest.SenderAuthentication); err != nil {
+		return err
+	}
+
 	er := &v1.EmailReceiver{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: system.EmailReceiverPrefix,
@@ -138,3 +155,27 @@ func (e *EmailReceiverHandler) List(req api.Context) error {
 
 	return req.Write(resp)
 }
+
+func validateEmailAttachmentOptions(opts *types.EmailAttachmentOptions) error {
+	if opts == nil {
+		return nil
+	}
+	if opts.MaxSize < 0 {
+		return types.NewErrBadRequest("invalid attachment max size %d: must not be negative", opts.MaxSize)
+	}
+	for _, allowedType := range opts.AllowedTypes {
+		if _, _, err := mime.ParseMediaType(allowedType); err != nil {
+			return types.NewErrBadRequest("invalid attachment type %q: %v", allowedType, err)
+		}
+	}
+	return nil
+}
+
+func validateSenderAuthentication(policy types.SenderAuthenticationPolicy) error {
+	switch policy {
+	case "", types.SenderAuthenticationAllow, types.SenderAuthenticationTag, types.SenderAuthenticationReject:
+		return nil
+	default:
+		return types.NewErrBadRequest("invalid sender authentication policy %q: must be allow, tag, or reject", policy)
+	}
+}
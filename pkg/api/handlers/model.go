@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
@@ -211,6 +212,15 @@ func validateModelManifestAndSetDefaults(newModel *v1.Model) error {
 	if newModel.Spec.Manifest.ModelProvider == "" {
 		errs = append(errs, fmt.Errorf("field modelProvider is required"))
 	}
+	if pricing := newModel.Spec.Manifest.Pricing; pricing != nil &&
+		(pricing.InputPerMillion < 0 || pricing.OutputPerMillion < 0 || pricing.CachedInputPerMillion < 0) {
+		errs = append(errs, fmt.Errorf("field pricing must not have negative prices"))
+	}
+	if cache := newModel.Spec.Manifest.ResponseCache; cache != nil && cache.TTL != "" {
+		if ttl, err := time.ParseDuration(cache.TTL); err != nil || ttl <= 0 {
+			errs = append(errs, fmt.Errorf("field responseCache.ttl must be a positive duration"))
+		}
+	}
 
 	return errors.Join(errs...)
 }
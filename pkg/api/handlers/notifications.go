@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"net/url"
+	"slices"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type NotificationHandler struct{}
+
+func NewNotificationHandler() *NotificationHandler {
+	return &NotificationHandler{}
+}
+
+// ListDeliveries lists the notifications that were sent for the runs of the tasks of a project, or of one task if the
+// task is in the path. The sink query parameter limits them to one sink.
+func (*NotificationHandler) ListDeliveries(req api.Context) error {
+	thread, err := getProjectThread(req)
+	if err != nil {
+		return err
+	}
+
+	selector := map[string]string{"spec.threadName": thread.Name}
+	if taskID := req.PathValue("id"); taskID != "" {
+		selector["spec.workflowName"] = taskID
+	}
+
+	var deliveries v1.NotificationDeliveryList
+	if err := req.List(&deliveries, &kclient.ListOptions{
+		Namespace:     thread.Namespace,
+		FieldSelector: fields.SelectorFromSet(selector),
+	}); err != nil {
+		return err
+	}
+
+	slices.SortFunc(deliveries.Items, func(a, b v1.NotificationDelivery) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	sink := req.URL.Query().Get("sink")
+	resp := make([]types.NotificationDelivery, 0, len(deliveries.Items))
+	for _, delivery := range deliveries.Items {
+		if sink == "" || delivery.Spec.Sink.Name == sink {
+			resp = append(resp, convertNotificationDelivery(delivery))
+		}
+	}
+
+	return req.Write(types.NotificationDeliveryList{Items: resp})
+}
+
+func convertNotificationDelivery(delivery v1.NotificationDelivery) types.NotificationDelivery {
+	result := types.NotificationDelivery{
+		Metadata: MetadataFrom(&delivery),
+		SinkName: delivery.Spec.Sink.Name,
+		SinkType: notificationSinkType(delivery.Spec.Sink),
+		Event:    delivery.Spec.Event,
+		TaskID:   delivery.Spec.WorkflowName,
+		RunID:    delivery.Spec.WorkflowExecutionName,
+		State:    delivery.Status.State,
+	}
+	if result.State == "" {
+		result.State = types.NotificationDeliveryStatePending
+	}
+	for _, attempt := range delivery.Status.Attempts {
+		result.Attempts = append(result.Attempts, types.NotificationAttempt{
+			Time:       *types.NewTime(attempt.Time.Time),
+			StatusCode: attempt.StatusCode,
+			Error:      attempt.Error,
+		})
+	}
+	return result
+}
+
+func notificationSinkType(sink types.NotificationSink) string {
+	switch {
+	case sink.Slack != nil:
+		return "slack"
+	case sink.HTTP != nil:
+		return "http"
+	case sink.Email != nil:
+		return "email"
+	}
+	return ""
+}
+
+func validateNotificationSinks(sinks []types.NotificationSink) error {
+	names := make(map[string]struct{}, len(sinks))
+	for _, sink := range sinks {
+		if sink.Name == "" {
+			return types.NewErrBadRequest("invalid notification sink: name is required")
+		}
+		if _, ok := names[sink.Name]; ok {
+			return types.NewErrBadRequest("invalid notification sink %s: name is not unique", sink.Name)
+		}
+		names[sink.Name] = struct{}{}
+
+		for _, event := range sink.Events {
+			switch event {
+			case types.NotificationEventComplete, types.NotificationEventError, types.NotificationEventApprovalNeeded:
+			default:
+				return types.NewErrBadRequest("invalid notification event %q for sink %s: must be complete, error, or approvalNeeded", event, sink.Name)
+			}
+		}
+
+		var count int
+		if sink.Slack != nil {
+			count++
+			if err := validateNotificationURL(sink.Slack.WebhookURL); err != nil {
+				return types.NewErrBadRequest("invalid slack webhook URL for sink %s: %v", sink.Name, err)
+			}
+		}
+		if sink.HTTP != nil {
+			count++
+			if err := validateNotificationURL(sink.HTTP.URL); err != nil {
+				return types.NewErrBadRequest("invalid http URL for sink %s: %v", sink.Name, err)
+			}
+		}
+		if sink.Email != nil {
+			count++
+			if len(sink.Email.To) == 0 {
+				return types.NewErrBadRequest("invalid email for sink %s: to is required", sink.Name)
+			}
+		}
+		if count != 1 {
+			return types.NewErrBadRequest("invalid notification sink %s: exactly one of slack, http, or email is required", sink.Name)
+		}
+	}
+	return nil
+}
+
+func validateNotificationURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Host == "" {
+		return errors.New("must be an http or https URL")
+	}
+	return nil
+}
+
+// redactNotificationSinks returns a copy of the sinks without their secrets, for API responses.
+func redactNotificationSinks(sinks []types.NotificationSink) []types.NotificationSink {
+	if sinks == nil {
+		return nil
+	}
+
+	redacted := make([]types.NotificationSink, 0, len(sinks))
+	for _, sink := range sinks {
+		if sink.HTTP != nil && sink.HTTP.Secret != "" {
+			http := *sink.HTTP
+			http.Secret = ""
+			sink.HTTP = &http
+		}
+		if sink.Slack != nil && sink.Slack.WebhookURL != "" {
+			slack := *sink.Slack
+			slack.WebhookURL = ""
+			sink.Slack = &slack
+		}
+		redacted = append(redacted, sink)
+	}
+	return redacted
+}
+
+// keepNotificationSecrets sets the secrets that were left empty in an update to those of the existing sinks with the
+// same name, because the secrets are not returned by the API.
+func keepNotificationSecrets(sinks, existing []types.NotificationSink) {
+	for i, sink := range sinks {
+		for _, e := range existing {
+			if e.Name != sink.Name {
+				continue
+			}
+			if sink.HTTP != nil && sink.HTTP.Secret == "" && e.HTTP != nil {
+				sinks[i].HTTP.Secret = e.HTTP.Secret
+			}
+			if sink.Slack != nil && sink.Slack.WebhookURL == "" && e.Slack != nil {
+				sinks[i].Slack.WebhookURL = e.Slack.WebhookURL
+			}
+			break
+		}
+	}
+}
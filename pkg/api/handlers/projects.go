@@ -244,6 +244,11 @@ func (h *ProjectsHandler) UpdateProject(req api.Context) error {
 	}
 
 	project.Tools = thread.Spec.Manifest.Tools
+	keepNotificationSecrets(project.Notifications, thread.Spec.Manifest.Notifications)
+
+	if err := validateNotificationSinks(project.Notifications); err != nil {
+		return err
+	}
 
 	if !equality.Semantic.DeepEqual(thread.Spec.Manifest, project) {
 		thread.Spec.Manifest = project
@@ -514,10 +519,12 @@ func (h *ProjectsHandler) getProjects(req api.Context, agent *v1.Agent, all bool
 }
 
 func convertProject(thread *v1.Thread, parentThread *v1.Thread) types.Project {
+	manifest := thread.Spec.Manifest
+	manifest.Notifications = redactNotificationSinks(manifest.Notifications)
 	p := types.Project{
 		Metadata: MetadataFrom(thread),
 		ProjectManifest: types.ProjectManifest{
-			ThreadManifest: thread.Spec.Manifest,
+			ThreadManifest: manifest,
 		},
 		ParentID:        strings.Replace(thread.Spec.ParentThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
 		SourceProjectID: strings.Replace(thread.Spec.SourceThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
@@ -618,6 +625,7 @@ func (h *ProjectsHandler) ListProjectThreads(req api.Context) error {
 		return h.streamThreads(req, func(t *v1.Thread) bool {
 			return !t.Spec.Project &&
 				!t.Spec.Ephemeral &&
+				!t.Spec.SystemTask &&
 				t.Spec.ParentThreadName == projectThread.Name &&
 				t.Spec.UserID == req.User.GetUID()
 		})
@@ -640,7 +648,7 @@ func (h *ProjectsHandler) ListProjectThreads(req api.Context) error {
 		if !thread.DeletionTimestamp.IsZero() {
 			continue
 		}
-		if thread.Spec.Ephemeral {
+		if thread.Spec.Ephemeral || thread.Spec.SystemTask {
 			continue
 		}
 		result.Items = append(result.Items, convertThread(thread))
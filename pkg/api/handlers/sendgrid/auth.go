@@ -0,0 +1,50 @@
+package sendgrid
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"github.com/sendgrid/sendgrid-go/helpers/inbound"
+)
+
+// authentication returns the SPF and DKIM results that SendGrid checked for the email, and the domains of its valid
+// DKIM signatures, which DMARC is checked with. SendGrid sends the SPF result as a word, such as "pass", and the DKIM
+// results as a list such as "{@example.com : pass, @example.net : fail}".
+func authentication(email *inbound.ParsedEmail) (*emailtrigger.Authentication, []string) {
+	result := &emailtrigger.Authentication{
+		SPF:   "none",
+		DKIM:  "none",
+		DMARC: "fail",
+	}
+
+	from := header(email.Headers, "From")
+	if from == "" {
+		from = email.ParsedValues["from"]
+	}
+	if addresses, err := mail.ParseAddressList(from); err == nil && len(addresses) == 1 {
+		result.From = addresses[0].Address
+	}
+
+	if spf := strings.ToLower(strings.TrimSpace(email.ParsedValues["SPF"])); spf != "" {
+		result.SPF = spf
+	}
+
+	var dkimDomains []string
+	for _, signature := range strings.Split(strings.Trim(strings.TrimSpace(email.ParsedValues["dkim"]), "{}"), ",") {
+		domain, status, ok := strings.Cut(signature, ":")
+		if !ok {
+			continue
+		}
+		domain = strings.TrimPrefix(strings.TrimSpace(domain), "@")
+		if strings.TrimSpace(status) == "pass" && domain != "" {
+			dkimDomains = append(dkimDomains, domain)
+		}
+		result.DKIM = "fail"
+	}
+	if len(dkimDomains) > 0 {
+		result.DKIM = "pass"
+	}
+
+	return result, dkimDomains
+}
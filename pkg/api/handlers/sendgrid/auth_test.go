@@ -0,0 +1,101 @@
+package sendgrid
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sendgrid/sendgrid-go/helpers/inbound"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthentication(t *testing.T) {
+	tests := []struct {
+		name            string
+		fields          map[string]string
+		wantFrom        string
+		wantSPF         string
+		wantDKIM        string
+		wantDKIMDomains []string
+	}{
+		{
+			name: "spf and dkim pass",
+			fields: map[string]string{
+				"headers": "From: Alice <alice@example.com>\nSubject: Hello\n",
+				"SPF":     "pass",
+				"dkim":    "{@example.com : pass}",
+			},
+			wantFrom:        "alice@example.com",
+			wantSPF:         "pass",
+			wantDKIM:        "pass",
+			wantDKIMDomains: []string{"example.com"},
+		},
+		{
+			name: "one of several signatures passes",
+			fields: map[string]string{
+				"headers": "from: alice@example.com\n",
+				"SPF":     "softfail",
+				"dkim":    "{@example.com : fail, @mail.example.com : pass}",
+			},
+			wantFrom:        "alice@example.com",
+			wantSPF:         "softfail",
+			wantDKIM:        "pass",
+			wantDKIMDomains: []string{"mail.example.com"},
+		},
+		{
+			name: "dkim fails",
+			fields: map[string]string{
+				"headers": "From: alice@example.com\n",
+				"SPF":     "fail",
+				"dkim":    "{@example.com : fail}",
+			},
+			wantFrom: "alice@example.com",
+			wantSPF:  "fail",
+			wantDKIM: "fail",
+		},
+		{
+			name: "not checked",
+			fields: map[string]string{
+				"from": "Alice <alice@example.com>",
+				"dkim": "none",
+			},
+			wantFrom: "alice@example.com",
+			wantSPF:  "none",
+			wantDKIM: "none",
+		},
+		{
+			name: "more than one from address",
+			fields: map[string]string{
+				"headers": "From: alice@example.com, bob@example.com\n",
+				"SPF":     "pass",
+			},
+			wantSPF:  "pass",
+			wantDKIM: "none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			w := multipart.NewWriter(&body)
+			require.NoError(t, w.WriteField("envelope", `{"from":"bounce@example.net","to":["task@obot.example"]}`))
+			for k, v := range tt.fields {
+				require.NoError(t, w.WriteField(k, v))
+			}
+			require.NoError(t, w.Close())
+
+			req := httptest.NewRequest("POST", "/api/sendgrid", &body)
+			req.Header.Set("Content-Type", w.FormDataContentType())
+			email, err := inbound.Parse(req)
+			require.NoError(t, err)
+
+			result, dkimDomains := authentication(email)
+			require.Equal(t, tt.wantFrom, result.From)
+			require.Equal(t, tt.wantSPF, result.SPF)
+			require.Equal(t, tt.wantDKIM, result.DKIM)
+			require.Equal(t, "fail", result.DMARC)
+			require.Equal(t, tt.wantDKIMDomains, dkimDomains)
+		})
+	}
+}
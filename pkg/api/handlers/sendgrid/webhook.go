@@ -2,11 +2,20 @@ package sendgrid
 
 import (
 	"fmt"
+	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gptscript-ai/go-gptscript"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
+	"github.com/obot-platform/obot/pkg/invoke"
+	"github.com/obot-platform/obot/pkg/smtp"
 	"github.com/sendgrid/sendgrid-go/helpers/inbound"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -17,8 +26,8 @@ type InboundWebhookHandler struct {
 	password     string
 }
 
-func NewInboundWebhookHandler(c kclient.Client, hostname string, username, password string) *InboundWebhookHandler {
-	emailTrigger := emailtrigger.EmailTrigger(c, hostname)
+func NewInboundWebhookHandler(c kclient.WithWatch, invoker *invoke.Invoker, gClient *gptscript.GPTScript, dispatcher *dispatcher.Dispatcher, workspaceProvider, hostname string, username, password string) *InboundWebhookHandler {
+	emailTrigger := emailtrigger.EmailTrigger(c, invoker, gClient, dispatcher, workspaceProvider, hostname)
 	return &InboundWebhookHandler{emailTrigger: emailTrigger, username: username, password: password}
 }
 
@@ -35,11 +44,51 @@ func (h *InboundWebhookHandler) InboundWebhookHandler(req api.Context) error {
 		return types.NewErrHTTP(http.StatusBadRequest, fmt.Sprintf("Failed to parse inbound email: %v", err))
 	}
 
-	subject := inboundEmail.Headers["Subject"]
-	if err := h.emailTrigger.Handler(req.Context(), inboundEmail.Envelope.From, inboundEmail.Envelope.To, subject, []byte(inboundEmail.TextBody)); err != nil {
+	attachments := make([]emailtrigger.Attachment, 0, len(inboundEmail.ParsedAttachments))
+	for _, attachment := range inboundEmail.ParsedAttachments {
+		data, err := io.ReadAll(attachment.File)
+		if err != nil {
+			return types.NewErrHTTP(http.StatusBadRequest, fmt.Sprintf("Failed to read attachment %q: %v", attachment.Filename, err))
+		}
+		attachments = append(attachments, emailtrigger.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Data:        data,
+		})
+	}
+	sort.Slice(attachments, func(i, j int) bool {
+		return attachments[i].Filename < attachments[j].Filename
+	})
+
+	if err := h.emailTrigger.Handler(req.Context(), emailtrigger.Email{
+		From:        inboundEmail.Envelope.From,
+		To:          inboundEmail.Envelope.To,
+		ReplyTo:     emailtrigger.ReplyAddress(header(inboundEmail.Headers, "Reply-To"), header(inboundEmail.Headers, "From"), inboundEmail.Envelope.From),
+		Subject:     inboundEmail.Headers["Subject"],
+		Body:        inboundEmail.TextBody,
+		MessageID:   inboundEmail.Headers["Message-ID"],
+		References:  emailtrigger.ParseMessageIDs(inboundEmail.Headers["References"], inboundEmail.Headers["In-Reply-To"]),
+		Attachments: attachments,
+		Authenticate: sync.OnceValue(func() *emailtrigger.Authentication {
+			result, dkimDomains := authentication(inboundEmail)
+			smtp.CheckDMARC(req.Context(), result, inboundEmail.Envelope.From, dkimDomains)
+			return result
+		}),
+	}); err != nil {
 		return types.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("Failed to handle inbound email: %v", err))
 	}
 
 	req.WriteHeader(http.StatusOK)
 	return nil
 }
+
+// header returns the value of a header of the email. The names of the headers are as the email client wrote them, so
+// they are compared without case.
+func header(headers map[string]string, name string) string {
+	for k, v := range headers {
+		if strings.EqualFold(k, name) {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
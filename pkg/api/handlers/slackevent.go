@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +20,7 @@ import (
 	"github.com/obot-platform/obot/pkg/api"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,24 +39,126 @@ type SlackEvent struct {
 	Challenge string `json:"challenge"`
 	TeamID    string `json:"team_id"`
 	APIAppID  string `json:"api_app_id"`
+	EventID   string `json:"event_id"`
 	Event     struct {
 		Type        string `json:"type"`
+		Subtype     string `json:"subtype"`
 		User        string `json:"user"`
+		BotID       string `json:"bot_id"`
 		Text        string `json:"text"`
 		ThreadTS    string `json:"thread_ts"`
 		ChannelType string `json:"channel_type"`
 		Channel     string `json:"channel"`
 		EventTS     string `json:"event_ts"`
 		TS          string `json:"ts"`
+		Reaction    string `json:"reaction"`
+		Item        struct {
+			Type    string `json:"type"`
+			Channel string `json:"channel"`
+			TS      string `json:"ts"`
+		} `json:"item"`
 	} `json:"event"`
+	Authorizations []struct {
+		UserID string `json:"user_id"`
+		IsBot  bool   `json:"is_bot"`
+	} `json:"authorizations"`
 }
 
-func (h *SlackEventHandler) validateRequest(req api.Context, event SlackEvent, body []byte) error {
+// SlackInteraction is the payload of an interaction with a button or other interactive element of a message.
+type SlackInteraction struct {
+	Type      string `json:"type"`
+	APIAppID  string `json:"api_app_id"`
+	TriggerID string `json:"trigger_id"`
+	Team      struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	Channel struct {
+		ID string `json:"id"`
+	} `json:"channel"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+	} `json:"actions"`
+}
+
+// slackMessage is an event, slash command, or interaction that can run the tasks of the projects of a Slack app.
+type slackMessage struct {
+	// ID is the event ID or trigger ID that Slack sends again when it retries the request, so that the tasks are only
+	// run once for it.
+	ID       string
+	Type     types.SlackEventType
+	AppID    string
+	TeamID   string
+	Channel  string
+	Reaction string
+	// Command is the slash command followed by its text.
+	Command   string
+	ActionIDs []string
+	// Input is the input of the tasks that are run.
+	Input map[string]any
+}
+
+// slackEventType returns the kind of the event, or an empty string if it should not run tasks.
+func slackEventType(event SlackEvent) types.SlackEventType {
+	switch event.Event.Type {
+	case "app_mention":
+		return types.SlackEventTypeMention
+	case "reaction_added":
+		return types.SlackEventTypeReaction
+	case "message":
+		// Ignore edits, deletions, and messages from bots, including the app's own replies.
+		if event.Event.Subtype != "" || event.Event.BotID != "" {
+			return ""
+		}
+		if event.Event.ChannelType == "im" {
+			return types.SlackEventTypeDirectMessage
+		}
+		// Messages that mention the app are handled as mentions.
+		for _, auth := range event.Authorizations {
+			if auth.IsBot && strings.Contains(event.Event.Text, "<@"+auth.UserID+">") {
+				return ""
+			}
+		}
+		return types.SlackEventTypeChannelMessage
+	}
+	return ""
+}
+
+// slackTaskMatches returns whether the message should run a task with the Slack trigger.
+func slackTaskMatches(trigger *types.TaskOnSlackMessage, msg slackMessage) bool {
+	events := trigger.Events
+	if len(events) == 0 {
+		events = []types.SlackEventType{types.SlackEventTypeMention}
+	}
+	if !slices.Contains(events, msg.Type) {
+		return false
+	}
+
+	if len(trigger.Channels) > 0 && msg.Type != types.SlackEventTypeDirectMessage && !slices.Contains(trigger.Channels, msg.Channel) {
+		return false
+	}
+
+	switch msg.Type {
+	case types.SlackEventTypeReaction:
+		return len(trigger.Reactions) == 0 || slices.Contains(trigger.Reactions, msg.Reaction)
+	case types.SlackEventTypeCommand:
+		return len(trigger.Commands) == 0 || slices.ContainsFunc(trigger.Commands, func(command string) bool {
+			return msg.Command == command || strings.HasPrefix(msg.Command, command+" ")
+		})
+	case types.SlackEventTypeInteraction:
+		return len(trigger.ActionIDs) == 0 || slices.ContainsFunc(msg.ActionIDs, func(actionID string) bool {
+			return slices.Contains(trigger.ActionIDs, actionID)
+		})
+	}
+
+	return true
+}
+
+func (h *SlackEventHandler) validateRequest(req api.Context, appID string, body []byte) error {
 	var slackReceivers v1.SlackReceiverList
 	if err := req.List(&slackReceivers, &client.ListOptions{
 		Namespace: req.Namespace(),
 		FieldSelector: fields.SelectorFromSet(map[string]string{
-			"spec.manifest.appID": event.APIAppID,
+			"spec.manifest.appID": appID,
 		}),
 	}); err != nil {
 		return err
@@ -120,40 +226,180 @@ func (h *SlackEventHandler) HandleEvent(req api.Context) error {
 	}
 	req.Request.Body = io.NopCloser(bytes.NewBuffer(body))
 
-	var event SlackEvent
+	var (
+		event       SlackEvent
+		eventFields map[string]json.RawMessage
+	)
 	if err := json.NewDecoder(bytes.NewBuffer(body)).Decode(&event); err != nil {
 		return types.NewErrBadRequest("failed to decode event: %v", err)
 	}
+	if err := json.Unmarshal(body, &eventFields); err != nil {
+		return types.NewErrBadRequest("failed to decode event: %v", err)
+	}
+	// The token is the deprecated verification token of the app, which must not be given to the task.
+	delete(eventFields, "token")
 
 	if event.Type == "url_verification" {
 		return req.Write(map[string]string{"challenge": event.Challenge})
 	}
 
-	if event.Event.Type != "app_mention" {
+	eventType := slackEventType(event)
+	if eventType == "" {
 		return req.Write(map[string]string{"status": "ignored"})
 	}
 
-	if event.APIAppID == "" {
+	channel := event.Event.Channel
+	if eventType == types.SlackEventTypeReaction {
+		channel = event.Event.Item.Channel
+	}
+
+	if err := h.dispatch(req, body, slackMessage{
+		ID:       event.EventID,
+		Type:     eventType,
+		AppID:    event.APIAppID,
+		TeamID:   event.TeamID,
+		Channel:  channel,
+		Reaction: event.Event.Reaction,
+		Input: map[string]any{
+			"event": eventFields,
+		},
+	}); err != nil {
+		return err
+	}
+
+	return req.Write("ok")
+}
+
+// HandleCommand handles the slash commands of Slack apps.
+func (h *SlackEventHandler) HandleCommand(req api.Context) error {
+	body, err := io.ReadAll(req.Request.Body)
+	if err != nil {
+		return types.NewErrBadRequest("failed to read request body: %v", err)
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return types.NewErrBadRequest("failed to decode command: %v", err)
+	}
+
+	command := make(map[string]string, len(values))
+	for k := range values {
+		// The token is the deprecated verification token of the app, which must not be given to the task.
+		if k != "token" {
+			command[k] = values.Get(k)
+		}
+	}
+
+	if err := h.dispatch(req, body, slackMessage{
+		ID:      values.Get("trigger_id"),
+		Type:    types.SlackEventTypeCommand,
+		AppID:   values.Get("api_app_id"),
+		TeamID:  values.Get("team_id"),
+		Channel: values.Get("channel_id"),
+		Command: strings.TrimSpace(values.Get("command") + " " + values.Get("text")),
+		Input: map[string]any{
+			"command": command,
+		},
+	}); err != nil {
+		return err
+	}
+
+	// An empty response acknowledges the command without posting a message.
+	req.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// HandleInteraction handles the interactions with buttons and other interactive elements of messages.
+func (h *SlackEventHandler) HandleInteraction(req api.Context) error {
+	body, err := io.ReadAll(req.Request.Body)
+	if err != nil {
+		return types.NewErrBadRequest("failed to read request body: %v", err)
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return types.NewErrBadRequest("failed to decode interaction: %v", err)
+	}
+
+	var (
+		payload       = []byte(values.Get("payload"))
+		interaction   SlackInteraction
+		payloadFields map[string]json.RawMessage
+	)
+	if err := json.Unmarshal(payload, &interaction); err != nil {
+		return types.NewErrBadRequest("failed to decode interaction: %v", err)
+	}
+	if err := json.Unmarshal(payload, &payloadFields); err != nil {
+		return types.NewErrBadRequest("failed to decode interaction: %v", err)
+	}
+	// The token is the deprecated verification token of the app, which must not be given to the task.
+	delete(payloadFields, "token")
+
+	if interaction.Type != "block_actions" {
+		req.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	actionIDs := make([]string, 0, len(interaction.Actions))
+	for _, action := range interaction.Actions {
+		actionIDs = append(actionIDs, action.ActionID)
+	}
+
+	if err := h.dispatch(req, body, slackMessage{
+		ID:        interaction.TriggerID,
+		Type:      types.SlackEventTypeInteraction,
+		AppID:     interaction.APIAppID,
+		TeamID:    interaction.Team.ID,
+		Channel:   interaction.Channel.ID,
+		ActionIDs: actionIDs,
+		Input: map[string]any{
+			"interaction": payloadFields,
+		},
+	}); err != nil {
+		return err
+	}
+
+	req.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// dispatch validates the request and runs the tasks of the projects of the app whose Slack triggers match the
+// message.
+func (h *SlackEventHandler) dispatch(req api.Context, body []byte, msg slackMessage) error {
+	if msg.AppID == "" {
 		return types.NewErrBadRequest("missing api_app_id")
 	}
 
-	if event.TeamID == "" {
+	if msg.TeamID == "" {
 		return types.NewErrBadRequest("missing team_id")
 	}
 
-	if err := h.validateRequest(req, event, body); err != nil {
+	if err := h.validateRequest(req, msg.AppID, body); err != nil {
 		return err
 	}
 
 	var slackTriggers v1.SlackTriggerList
 
 	if err := req.List(&slackTriggers, client.MatchingFields{
-		"spec.appID":  event.APIAppID,
-		"spec.teamID": event.TeamID,
+		"spec.appID":  msg.AppID,
+		"spec.teamID": msg.TeamID,
 	}); err != nil {
 		return err
 	}
 
+	input := map[string]any{
+		"type":      "slack",
+		"eventType": msg.Type,
+	}
+	for k, v := range msg.Input {
+		input[k] = v
+	}
+
+	var payload = &strings.Builder{}
+	if err := json.NewEncoder(payload).Encode(input); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, trigger := range slackTriggers.Items {
 		var workflows v1.WorkflowList
@@ -164,16 +410,12 @@ func (h *SlackEventHandler) HandleEvent(req api.Context) error {
 			return err
 		}
 
-		var payload = &strings.Builder{}
-		if err := json.NewEncoder(payload).Encode(map[string]interface{}{
-			"type":  "slack",
-			"event": json.RawMessage(body),
-		}); err != nil {
-			return err
-		}
-
 		for _, workflow := range workflows.Items {
-			err := req.Create(&v1.WorkflowExecution{
+			if !slackTaskMatches(workflow.Spec.Manifest.OnSlackMessage, msg) {
+				continue
+			}
+
+			wfe := &v1.WorkflowExecution{
 				ObjectMeta: metav1.ObjectMeta{
 					GenerateName: system.WorkflowExecutionPrefix,
 					Namespace:    req.Namespace(),
@@ -183,8 +425,15 @@ func (h *SlackEventHandler) HandleEvent(req api.Context) error {
 					ThreadName:   workflow.Spec.ThreadName,
 					WorkflowName: workflow.Name,
 				},
-			})
-			if err != nil {
+			}
+			if msg.ID != "" {
+				// Slack retries requests that are not acknowledged in time, so the execution is named after the
+				// message and a retry finds the one that was already created.
+				wfe.GenerateName = ""
+				id := sha256.Sum256([]byte(workflow.Name + "/" + msg.ID))
+				wfe.Name = system.WorkflowExecutionPrefix + hex.EncodeToString(id[:16])
+			}
+			if err := req.Create(wfe); err != nil && !apierrors.IsAlreadyExists(err) {
 				errs = append(errs, err)
 			}
 		}
@@ -194,5 +443,5 @@ func (h *SlackEventHandler) HandleEvent(req api.Context) error {
 		return types.NewErrBadRequest("failed to create workflow execution: %v", errs)
 	}
 
-	return req.Write("ok")
+	return nil
 }
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/workflow"
+	"github.com/obot-platform/obot/pkg/gz"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (t *TaskHandler) ReplayRunFromScope(req api.Context) error {
+	workflow, projectThread, err := t.getTask(req)
+	if err != nil {
+		return err
+	}
+
+	return t.replayRun(req, workflow, projectThread.Name)
+}
+
+func (t *TaskHandler) replayRun(req api.Context, wf *v1.Workflow, threadName string) error {
+	wfe, err := getTaskRun(req, wf, req.PathValue("run_id"))
+	if err != nil {
+		return err
+	}
+
+	var replay types.TaskRunReplayRequest
+	if err := req.Read(&replay); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("invalid replay request: %v", err)
+	}
+
+	input := wfe.Spec.Input
+	if replay.Input != nil {
+		if !utf8.ValidString(*replay.Input) {
+			return types.NewErrBadRequest("invalid non-utf8 input")
+		}
+		input = *replay.Input
+	}
+
+	manifest := wfe.Status.WorkflowManifest
+	if replay.Task != nil {
+		if err := validate(*replay.Task); err != nil {
+			return err
+		}
+		newManifest := workflow.PopulateIDs(ToWorkflowManifest(*replay.Task))
+		manifest = &newManifest
+	}
+
+	newWFE := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WorkflowExecutionPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			Input:        input,
+			ThreadName:   threadName,
+			WorkflowName: wf.Name,
+			Manifest:     manifest,
+			ReplayOf:     wfe.Name,
+		},
+	}
+	if err := req.Create(newWFE); err != nil {
+		return err
+	}
+
+	return req.WriteCreated(convertTaskRun(wf, newWFE))
+}
+
+func (t *TaskHandler) DiffRunsFromScope(req api.Context) error {
+	workflow, _, err := t.getTask(req)
+	if err != nil {
+		return err
+	}
+
+	return t.diffRuns(req, workflow)
+}
+
+func (t *TaskHandler) diffRuns(req api.Context, workflow *v1.Workflow) error {
+	wfe, err := getTaskRun(req, workflow, req.PathValue("run_id"))
+	if err != nil {
+		return err
+	}
+
+	other, err := getTaskRun(req, workflow, req.PathValue("other_run_id"))
+	if err != nil {
+		return err
+	}
+
+	results, order, err := stepResults(req, wfe)
+	if err != nil {
+		return err
+	}
+
+	otherResults, otherOrder, err := stepResults(req, other)
+	if err != nil {
+		return err
+	}
+
+	for _, stepID := range otherOrder {
+		if _, ok := results[stepID]; !ok {
+			order = append(order, stepID)
+		}
+	}
+
+	diff := types.TaskRunDiff{
+		RunID:        wfe.Name,
+		OtherRunID:   other.Name,
+		InputChanged: wfe.Spec.Input != other.Spec.Input,
+		TaskChanged:  !reflect.DeepEqual(wfe.Status.WorkflowManifest, other.Status.WorkflowManifest),
+	}
+	for _, stepID := range order {
+		run, otherRun := results[stepID], otherResults[stepID]
+		diff.Steps = append(diff.Steps, types.TaskRunStepDiff{
+			StepID:  stepID,
+			Changed: stepResultChanged(run, otherRun),
+			Run:     run,
+			Other:   otherRun,
+		})
+	}
+
+	return req.Write(diff)
+}
+
+func getTaskRun(req api.Context, workflow *v1.Workflow, runID string) (*v1.WorkflowExecution, error) {
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, runID); err != nil {
+		return nil, err
+	}
+	if wfe.Spec.WorkflowName != workflow.Name {
+		return nil, types.NewErrNotFound("task run %s not found", runID)
+	}
+	return &wfe, nil
+}
+
+// stepResults returns the result of each step of the run by step ID, along with the step IDs in the order the
+// steps were created.
+func stepResults(req api.Context, wfe *v1.WorkflowExecution) (map[string]*types.TaskRunStepResult, []string, error) {
+	var steps v1.WorkflowStepList
+	if err := req.List(&steps, kclient.MatchingFields{
+		"spec.workflowExecutionName": wfe.Name,
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	sort.SliceStable(steps.Items, func(i, j int) bool {
+		return steps.Items[i].CreationTimestamp.Before(&steps.Items[j].CreationTimestamp)
+	})
+
+	var (
+		results = make(map[string]*types.TaskRunStepResult, len(steps.Items))
+		order   = make([]string, 0, len(steps.Items))
+	)
+	for _, step := range steps.Items {
+		result, err := stepResult(req, &step)
+		if err != nil {
+			return nil, nil, err
+		}
+		results[step.Spec.Step.ID] = result
+		order = append(order, step.Spec.Step.ID)
+	}
+
+	return results, order, nil
+}
+
+func stepResult(req api.Context, step *v1.WorkflowStep) (*types.TaskRunStepResult, error) {
+	result := &types.TaskRunStepResult{
+		Step:     step.Spec.Step.Step,
+		State:    step.Status.State,
+		Output:   step.Status.StructuredOutput,
+		Error:    step.Status.Error,
+		Attempts: len(step.Status.RunNames),
+	}
+
+	if result.Output == "" && step.Status.LastRunName != "" {
+		var run v1.Run
+		if err := req.Get(&run, step.Status.LastRunName); err == nil {
+			result.Output = run.Status.Output
+		} else if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	for _, runName := range step.Status.RunNames {
+		runState, err := req.GatewayClient.RunState(req.Context(), req.Namespace(), runName)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		var frames gptscript.CallFrames
+		if err := gz.Decompress(&frames, runState.CallFrame); err != nil {
+			return nil, err
+		}
+
+		calls := make([]gptscript.CallFrame, 0, len(frames))
+		for _, frame := range frames {
+			result.TokenUsage.PromptTokens += frame.Usage.PromptTokens
+			result.TokenUsage.CompletionTokens += frame.Usage.CompletionTokens
+			result.TokenUsage.TotalTokens += frame.Usage.TotalTokens
+			if frame.ParentID != "" && frame.ToolCategory == gptscript.NoCategory {
+				calls = append(calls, frame)
+			}
+		}
+
+		sort.Slice(calls, func(i, j int) bool {
+			return calls[i].Start.Before(calls[j].Start)
+		})
+		for _, call := range calls {
+			result.ToolCalls = append(result.ToolCalls, call.Tool.Name)
+		}
+	}
+
+	return result, nil
+}
+
+func stepResultChanged(a, b *types.TaskRunStepResult) bool {
+	if a == nil || b == nil {
+		return a != b
+	}
+	return a.Step != b.Step ||
+		a.State != b.State ||
+		a.Output != b.Output ||
+		a.Error != b.Error ||
+		!slices.Equal(a.ToolCalls, b.ToolCalls)
+}
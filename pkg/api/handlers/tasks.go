@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -13,11 +15,14 @@ import (
 	"github.com/obot-platform/obot/apiclient"
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/controller/handlers/cronjob"
+	"github.com/obot-platform/obot/pkg/controller/handlers/workflowstep"
 	"github.com/obot-platform/obot/pkg/events"
 	"github.com/obot-platform/obot/pkg/invoke"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	"github.com/obot-platform/obot/pkg/wait"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -186,6 +191,119 @@ func (t *TaskHandler) abortRun(req api.Context, workflow *v1.Workflow) error {
 	return abortThread(req, &thread)
 }
 
+func (t *TaskHandler) ApproveStepFromScope(req api.Context) error {
+	workflow, _, err := t.getTask(req)
+	if err != nil {
+		return err
+	}
+
+	return t.approveStep(req, workflow)
+}
+
+func (t *TaskHandler) approveStep(req api.Context, workflow *v1.Workflow) error {
+	var (
+		wfe    v1.WorkflowExecution
+		stepID = req.PathValue("step_id")
+		input  types.StepApprovalRequest
+	)
+
+	if err := req.Get(&wfe, req.PathValue("run_id")); err != nil {
+		return err
+	}
+
+	if wfe.Spec.WorkflowName != workflow.Name {
+		return types.NewErrNotFound("task run not found")
+	}
+
+	if err := req.Read(&input); err != nil && !errors.Is(err, io.EOF) {
+		return types.NewErrBadRequest("invalid approval request: %v", err)
+	}
+
+	var steps v1.WorkflowStepList
+	if err := req.List(&steps, kclient.MatchingFields{
+		"spec.workflowExecutionName": wfe.Name,
+	}); err != nil {
+		return err
+	}
+
+	var step *v1.WorkflowStep
+	for i := range steps.Items {
+		if steps.Items[i].Spec.Step.ID == stepID && steps.Items[i].Spec.Step.Approval != nil {
+			step = &steps.Items[i]
+			break
+		}
+	}
+
+	if step == nil {
+		return types.NewErrNotFound("approval step %s not found", stepID)
+	}
+
+	if !isApprover(req, step.Spec.Step.Approval.Approvers) {
+		return types.NewErrHTTP(http.StatusForbidden, "user is not an approver for this step")
+	}
+
+	var run v1.Run
+	if len(step.Status.RunNames) > 0 {
+		if err := req.Get(&run, step.Status.RunNames[len(step.Status.RunNames)-1]); err != nil {
+			return err
+		}
+	}
+
+	if decision, err := workflowstep.ApprovalDecision(&run); err != nil {
+		return err
+	} else if run.Status.State != v1.Waiting || run.Spec.WaitFor == nil || decision != nil {
+		return types.NewErrHTTP(http.StatusConflict, "step is not waiting for approval")
+	}
+
+	decision := types.StepApprovalStatus{
+		Decision:  types.ApprovalDecisionApproved,
+		DecidedBy: req.User.GetName(),
+		Comment:   input.Comment,
+	}
+	if input.Reject {
+		decision.Decision = types.ApprovalDecisionRejected
+	}
+
+	if err := workflowstep.Decide(req.Context(), req.Storage, &run, decision); err != nil {
+		return err
+	}
+
+	return req.Write(convertStepApproval(step, &run))
+}
+
+func isApprover(req api.Context, approvers []string) bool {
+	if len(approvers) == 0 {
+		return true
+	}
+
+	var email string
+	if attr := req.User.GetExtra()["email"]; len(attr) > 0 {
+		email = attr[0]
+	}
+
+	for _, approver := range approvers {
+		if approver == req.User.GetUID() || approver == req.User.GetName() || (email != "" && strings.EqualFold(approver, email)) {
+			return true
+		}
+	}
+	return false
+}
+
+func convertStepApproval(step *v1.WorkflowStep, run *v1.Run) types.StepApprovalStatus {
+	result := types.StepApprovalStatus{
+		StepID:      step.Spec.Step.ID,
+		Message:     step.Spec.Step.Approval.Message,
+		RequestedAt: types.NewTime(run.CreationTimestamp.Time),
+	}
+	if decision, _ := workflowstep.ApprovalDecision(run); decision != nil {
+		result.Decision = decision.Decision
+		result.DecidedBy = decision.DecidedBy
+		result.DecidedAt = decision.DecidedAt
+		result.Comment = decision.Comment
+	}
+	return result
+}
+
 func (t *TaskHandler) GetRunFromScope(req api.Context) error {
 	workflow, _, err := t.getTask(req)
 	if err != nil {
@@ -385,6 +503,7 @@ func convertTaskRun(workflow *v1.Workflow, wfe *v1.WorkflowExecution) types.Task
 		StartTime: types.NewTime(wfe.CreationTimestamp.Time),
 		EndTime:   endTime,
 		Error:     wfe.Status.Error,
+		ReplayOf:  wfe.Spec.ReplayOf,
 	}
 }
 
@@ -431,6 +550,7 @@ func (t *TaskHandler) Update(req api.Context) error {
 		}
 	}
 
+	keepNotificationSecrets(manifest.Notifications, workflow.Spec.Manifest.Notifications)
 	workflow.Spec.Manifest = manifest
 	if err := req.Update(&workflow); err != nil {
 		return err
@@ -463,6 +583,7 @@ func (t *TaskHandler) UpdateFromScope(req api.Context) error {
 		}
 	}
 
+	keepNotificationSecrets(manifest.Notifications, workflow.Spec.Manifest.Notifications)
 	workflow.Spec.Manifest = manifest
 	if err := req.Update(workflow); err != nil {
 		return err
@@ -499,8 +620,112 @@ func validate(task types.TaskManifest) error {
 	if task.OnSlackMessage != nil {
 		count++
 	}
+	if task.OnTaskCompletion != nil {
+		count++
+	}
 	if count > 1 {
-		return types.NewErrBadRequest("only one trigger is allowed, schedule, webhook, onDemand, onSlackMessage, or email")
+		return types.NewErrBadRequest("only one trigger is allowed, schedule, webhook, onDemand, onSlackMessage, onTaskCompletion, or email")
+	}
+	if task.OnTaskCompletion != nil {
+		if task.OnTaskCompletion.TaskID == "" {
+			return types.NewErrBadRequest("invalid onTaskCompletion trigger: taskID is required")
+		}
+		for _, state := range task.OnTaskCompletion.States {
+			if state != types.WorkflowStateComplete && state != types.WorkflowStateError {
+				return types.NewErrBadRequest("invalid onTaskCompletion state %q: must be Complete or Error", state)
+			}
+		}
+	}
+	if err := validateNotificationSinks(task.Notifications); err != nil {
+		return err
+	}
+	if task.Concurrency != nil {
+		switch task.Concurrency.Policy {
+		case "", types.ConcurrencyPolicyAllow, types.ConcurrencyPolicyForbid, types.ConcurrencyPolicyReplace, types.ConcurrencyPolicyQueue:
+		default:
+			return types.NewErrBadRequest("invalid concurrency policy %q: must be allow, forbid, replace, or queue", task.Concurrency.Policy)
+		}
+		if task.Concurrency.MaxQueueDepth < 0 {
+			return types.NewErrBadRequest("invalid max queue depth %d: must not be negative", task.Concurrency.MaxQueueDepth)
+		}
+	}
+	if task.OnSlackMessage != nil {
+		for _, event := range task.OnSlackMessage.Events {
+			switch event {
+			case types.SlackEventTypeMention, types.SlackEventTypeDirectMessage, types.SlackEventTypeChannelMessage,
+				types.SlackEventTypeReaction, types.SlackEventTypeCommand, types.SlackEventTypeInteraction:
+			default:
+				return types.NewErrBadRequest("invalid onSlackMessage event %q: must be mention, directMessage, channelMessage, reaction, command, or interaction", event)
+			}
+		}
+		for _, command := range task.OnSlackMessage.Commands {
+			if !strings.HasPrefix(command, "/") {
+				return types.NewErrBadRequest("invalid onSlackMessage command %q: must start with /", command)
+			}
+		}
+	}
+	if task.Webhook != nil {
+		if err := validateWebhookExpressions(task.Webhook.Filter, task.Webhook.Params); err != nil {
+			return err
+		}
+		if _, err := synchronousTimeout(task.Webhook.SynchronousTimeout); err != nil {
+			return types.NewErrBadRequest("%v", err)
+		}
+	}
+	if task.Email != nil {
+		if err := validateEmailAttachmentOptions(task.Email.Attachments); err != nil {
+			return err
+		}
+		if err := validateSenderAuthentication(task.Email.SenderAuthentication); err != nil {
+			return err
+		}
+	}
+	if task.Schedule != nil {
+		if err := cronjob.ValidateScheduleOptions(task.Schedule.ScheduleOptions); err != nil {
+			return types.NewErrBadRequest("invalid schedule: %v", err)
+		}
+	}
+	return validateSteps(toWorkflowSteps(task.Steps))
+}
+
+func validateSteps(steps []types.Step) error {
+	for _, step := range steps {
+		if step.Retries < 0 {
+			return types.NewErrBadRequest("invalid retries for step %s: must not be negative", step.ID)
+		}
+		if step.Timeout != "" {
+			if _, err := time.ParseDuration(step.Timeout); err != nil {
+				return types.NewErrBadRequest("invalid timeout for step %s: %v", step.ID, err)
+			}
+		}
+		if step.Backoff != "" {
+			if _, err := time.ParseDuration(step.Backoff); err != nil {
+				return types.NewErrBadRequest("invalid backoff for step %s: %v", step.ID, err)
+			}
+		}
+		if step.OutputSchema != "" {
+			if _, err := workflowstep.ParseOutputSchema(step.OutputSchema); err != nil {
+				return types.NewErrBadRequest("step %s: %v", step.ID, err)
+			}
+		}
+		if step.Task != nil && step.Task.ID == "" {
+			return types.NewErrBadRequest("invalid task for step %s: id is required", step.ID)
+		}
+		if step.Approval != nil {
+			if step.Approval.Timeout != "" {
+				if _, err := time.ParseDuration(step.Approval.Timeout); err != nil {
+					return types.NewErrBadRequest("invalid approval timeout for step %s: %v", step.ID, err)
+				}
+			}
+			if action := step.Approval.DefaultAction; action != "" && action != "approve" && action != "reject" {
+				return types.NewErrBadRequest("invalid approval default action for step %s: must be approve or reject", step.ID)
+			}
+		}
+		for _, branch := range step.Branches() {
+			if err := validateSteps(branch); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -554,8 +779,11 @@ func (t *TaskHandler) updateEmail(req api.Context, workflow *v1.Workflow, task t
 			},
 			Spec: v1.EmailReceiverSpec{
 				EmailReceiverManifest: types.EmailReceiverManifest{
-					Alias:        workflow.Spec.Manifest.Alias,
-					WorkflowName: workflow.Name,
+					Alias:                workflow.Spec.Manifest.Alias,
+					WorkflowName:         workflow.Name,
+					Attachments:          task.Email.Attachments,
+					Reply:                task.Email.Reply,
+					SenderAuthentication: task.Email.SenderAuthentication,
 				},
 				ThreadName: workflow.Spec.ThreadName,
 			},
@@ -563,6 +791,14 @@ func (t *TaskHandler) updateEmail(req api.Context, workflow *v1.Workflow, task t
 		if err := req.Create(&email); err != nil {
 			return err
 		}
+	} else if !equality.Semantic.DeepEqual(email.Spec.Attachments, task.Email.Attachments) || email.Spec.Reply != task.Email.Reply ||
+		email.Spec.SenderAuthentication != task.Email.SenderAuthentication {
+		email.Spec.Attachments = task.Email.Attachments
+		email.Spec.Reply = task.Email.Reply
+		email.Spec.SenderAuthentication = task.Email.SenderAuthentication
+		if err := req.Update(&email); err != nil {
+			return err
+		}
 	}
 
 	trigger.Email = &email
@@ -592,8 +828,13 @@ func (t *TaskHandler) updateWebhook(req api.Context, workflow *v1.Workflow, task
 			},
 			Spec: v1.WebhookSpec{
 				WebhookManifest: types.WebhookManifest{
-					Alias:        workflow.Spec.Manifest.Alias,
-					WorkflowName: workflow.Name,
+					Alias:              workflow.Spec.Manifest.Alias,
+					WorkflowName:       workflow.Name,
+					Filter:             task.Webhook.Filter,
+					Params:             task.Webhook.Params,
+					DeliveryIDHeader:   task.Webhook.DeliveryIDHeader,
+					Synchronous:        task.Webhook.Synchronous,
+					SynchronousTimeout: task.Webhook.SynchronousTimeout,
 				},
 				ThreadName: workflow.Spec.ThreadName,
 			},
@@ -601,6 +842,17 @@ func (t *TaskHandler) updateWebhook(req api.Context, workflow *v1.Workflow, task
 		if err := req.Create(&webhook); err != nil {
 			return err
 		}
+	} else if webhook.Spec.Filter != task.Webhook.Filter || !equality.Semantic.DeepEqual(webhook.Spec.Params, task.Webhook.Params) ||
+		webhook.Spec.DeliveryIDHeader != task.Webhook.DeliveryIDHeader || webhook.Spec.Synchronous != task.Webhook.Synchronous ||
+		webhook.Spec.SynchronousTimeout != task.Webhook.SynchronousTimeout {
+		webhook.Spec.Filter = task.Webhook.Filter
+		webhook.Spec.Params = task.Webhook.Params
+		webhook.Spec.DeliveryIDHeader = task.Webhook.DeliveryIDHeader
+		webhook.Spec.Synchronous = task.Webhook.Synchronous
+		webhook.Spec.SynchronousTimeout = task.Webhook.SynchronousTimeout
+		if err := req.Update(&webhook); err != nil {
+			return err
+		}
 	}
 
 	trigger.Webhook = &webhook
@@ -644,7 +896,7 @@ func (t *TaskHandler) updateCron(req api.Context, workflow *v1.Workflow, task ty
 	}
 
 	trigger.CronJob = &cron
-	if cron.Spec.TaskSchedule == nil || *cron.Spec.TaskSchedule != *task.Schedule {
+	if cron.Spec.TaskSchedule == nil || !equality.Semantic.DeepEqual(*cron.Spec.TaskSchedule, *task.Schedule) {
 		cron.Spec.TaskSchedule = task.Schedule
 		return req.Update(&cron)
 	}
@@ -657,11 +909,7 @@ func (t *TaskHandler) updateSlack(req api.Context, workflow *v1.Workflow, task t
 		if err := req.Storage.Get(req.Context(), kclient.ObjectKeyFromObject(workflow), workflow); err != nil {
 			return err
 		}
-		if task.OnSlackMessage == nil {
-			workflow.Spec.Manifest.OnSlackMessage = nil
-		} else {
-			workflow.Spec.Manifest.OnSlackMessage = &types.TaskOnSlackMessage{}
-		}
+		workflow.Spec.Manifest.OnSlackMessage = task.OnSlackMessage
 		return req.Update(workflow)
 	})
 }
@@ -742,11 +990,14 @@ func (t *TaskHandler) CreateFromScope(req api.Context) error {
 
 func ToWorkflowManifest(manifest types.TaskManifest) types.WorkflowManifest {
 	return types.WorkflowManifest{
-		Name:           manifest.Name,
-		Description:    manifest.Description,
-		Steps:          toWorkflowSteps(manifest.Steps),
-		Params:         toParams(manifest),
-		OnSlackMessage: manifest.OnSlackMessage,
+		Name:             manifest.Name,
+		Description:      manifest.Description,
+		Steps:            toWorkflowSteps(manifest.Steps),
+		Params:           toParams(manifest),
+		OnSlackMessage:   manifest.OnSlackMessage,
+		OnTaskCompletion: manifest.OnTaskCompletion,
+		Concurrency:      manifest.Concurrency,
+		Notifications:    manifest.Notifications,
 	}
 }
 
@@ -964,10 +1215,13 @@ func ConvertTaskManifest(manifest *types.WorkflowManifest) types.TaskManifest {
 		return types.TaskManifest{}
 	}
 	return types.TaskManifest{
-		Name:           manifest.Name,
-		Description:    manifest.Description,
-		Steps:          toTaskSteps(manifest.Steps),
-		OnSlackMessage: manifest.OnSlackMessage,
+		Name:             manifest.Name,
+		Description:      manifest.Description,
+		Steps:            toTaskSteps(manifest.Steps),
+		OnSlackMessage:   manifest.OnSlackMessage,
+		OnTaskCompletion: manifest.OnTaskCompletion,
+		Concurrency:      manifest.Concurrency,
+		Notifications:    redactNotificationSinks(manifest.Notifications),
 	}
 }
 
@@ -983,10 +1237,20 @@ func convertTask(workflow v1.Workflow, trigger *triggers) types.Task {
 		task.Schedule = trigger.CronJob.Spec.TaskSchedule
 	}
 	if trigger != nil && trigger.Webhook != nil && trigger.Webhook.Name != "" {
-		task.Webhook = &types.TaskWebhook{}
+		task.Webhook = &types.TaskWebhook{
+			Filter:             trigger.Webhook.Spec.Filter,
+			Params:             trigger.Webhook.Spec.Params,
+			DeliveryIDHeader:   trigger.Webhook.Spec.DeliveryIDHeader,
+			Synchronous:        trigger.Webhook.Spec.Synchronous,
+			SynchronousTimeout: trigger.Webhook.Spec.SynchronousTimeout,
+		}
 	}
 	if trigger != nil && trigger.Email != nil && trigger.Email.Name != "" {
-		task.Email = &types.TaskEmail{}
+		task.Email = &types.TaskEmail{
+			Attachments:          trigger.Email.Spec.Attachments,
+			Reply:                trigger.Email.Spec.Reply,
+			SenderAuthentication: trigger.Email.Spec.SenderAuthentication,
+		}
 	}
 	if len(workflow.Spec.Manifest.Params) > 0 {
 		task.OnDemand = &types.TaskOnDemand{
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/teams"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type TeamsHandler struct {
+	validator *teams.Validator
+}
+
+func NewTeamsHandler() *TeamsHandler {
+	return &TeamsHandler{
+		validator: teams.NewValidator(),
+	}
+}
+
+func (t *TeamsHandler) Create(req api.Context) error {
+	thread, err := getThreadForScope(req)
+	if err != nil {
+		return err
+	}
+
+	var input types.TeamsReceiver
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	if err := validateTeamsInput(req, input, system.TeamsReceiverPrefix+thread.Name, false); err != nil {
+		return err
+	}
+
+	teamsReceiver := v1.TeamsReceiver{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.TeamsReceiverPrefix + thread.Name,
+			Namespace: req.Namespace(),
+		},
+		Spec: v1.TeamsReceiverSpec{
+			Manifest:   input.TeamsReceiverManifest,
+			ThreadName: thread.Name,
+		},
+	}
+
+	if err := req.Create(&teamsReceiver); err != nil {
+		return err
+	}
+
+	if err := req.GPTClient.CreateCredential(req.Context(), newTeamsCred(teamsReceiver.Name, input.AppPassword)); err != nil {
+		return err
+	}
+
+	return req.WriteCreated(convertTeamsReceiver(teamsReceiver))
+}
+
+func newTeamsCred(receiverName, appPassword string) gptscript.Credential {
+	return gptscript.Credential{
+		Context:  receiverName,
+		ToolName: teams.CredentialToolName,
+		Type:     gptscript.CredentialTypeTool,
+		Env: map[string]string{
+			"APP_PASSWORD": appPassword,
+		},
+	}
+}
+
+func convertTeamsReceiver(teamsReceiver v1.TeamsReceiver) types.TeamsReceiver {
+	return types.TeamsReceiver{
+		Metadata:              MetadataFrom(&teamsReceiver),
+		TeamsReceiverManifest: teamsReceiver.Spec.Manifest,
+	}
+}
+
+func (t *TeamsHandler) Update(req api.Context) error {
+	thread, err := getThreadForScope(req)
+	if err != nil {
+		return err
+	}
+
+	var input types.TeamsReceiver
+	if err := req.Read(&input); err != nil {
+		return err
+	}
+
+	var teamsReceiver v1.TeamsReceiver
+	if err := req.Get(&teamsReceiver, system.TeamsReceiverPrefix+thread.Name); err != nil {
+		return err
+	}
+
+	if err := validateTeamsInput(req, input, teamsReceiver.Name, true); err != nil {
+		return err
+	}
+
+	teamsReceiver.Spec.Manifest = input.TeamsReceiverManifest
+	if err := req.Update(&teamsReceiver); err != nil {
+		return err
+	}
+
+	if input.AppPassword != "" {
+		if err := req.GPTClient.CreateCredential(req.Context(), newTeamsCred(teamsReceiver.Name, input.AppPassword)); err != nil {
+			return err
+		}
+	}
+
+	return req.Write(convertTeamsReceiver(teamsReceiver))
+}
+
+func (t *TeamsHandler) Get(req api.Context) error {
+	thread, err := getThreadForScope(req)
+	if err != nil {
+		return err
+	}
+
+	var teamsReceiver v1.TeamsReceiver
+	if err := req.Get(&teamsReceiver, system.TeamsReceiverPrefix+thread.Name); err != nil {
+		return err
+	}
+
+	return req.Write(convertTeamsReceiver(teamsReceiver))
+}
+
+func (t *TeamsHandler) Delete(req api.Context) error {
+	thread, err := getThreadForScope(req)
+	if err != nil {
+		return err
+	}
+
+	return req.Delete(&v1.TeamsReceiver{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.TeamsReceiverPrefix + thread.Name,
+			Namespace: req.Namespace(),
+		},
+	})
+}
+
+// validateTeamsInput checks the input and that no other project uses the same app, because the app of an activity
+// decides which project it is sent to.
+func validateTeamsInput(req api.Context, input types.TeamsReceiver, receiverName string, update bool) error {
+	if input.AppID == "" {
+		return errors.New("appId is required")
+	}
+	if input.AppPassword == "" && !update {
+		return errors.New("appPassword is required")
+	}
+
+	var teamsReceivers v1.TeamsReceiverList
+	if err := req.List(&teamsReceivers, &kclient.ListOptions{
+		Namespace: req.Namespace(),
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"spec.manifest.appID": input.AppID,
+		}),
+	}); err != nil {
+		return err
+	}
+
+	for _, receiver := range teamsReceivers.Items {
+		if receiver.Name != receiverName {
+			return types.NewErrHTTP(http.StatusConflict, fmt.Sprintf("app %s is already used by another project", input.AppID))
+		}
+	}
+
+	return nil
+}
+
+// HandleActivity receives the activities that the Bot Framework sends for Teams apps. Messages are saved to be run in
+// the thread of their conversation, and the response is sent back as a reply to the message once the run is done.
+func (t *TeamsHandler) HandleActivity(req api.Context) error {
+	token, ok := strings.CutPrefix(req.Request.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return types.NewErrHTTP(http.StatusUnauthorized, "missing bearer token")
+	}
+
+	var activity teams.Activity
+	if err := req.Read(&activity); err != nil {
+		return types.NewErrBadRequest("failed to decode activity: %v", err)
+	}
+
+	appID, err := teams.Audience(token)
+	if err != nil {
+		return types.NewErrHTTP(http.StatusUnauthorized, err.Error())
+	}
+
+	var teamsReceivers v1.TeamsReceiverList
+	if err := req.List(&teamsReceivers, &kclient.ListOptions{
+		Namespace: req.Namespace(),
+		FieldSelector: fields.SelectorFromSet(map[string]string{
+			"spec.manifest.appID": appID,
+		}),
+	}); err != nil {
+		return err
+	}
+
+	if len(teamsReceivers.Items) != 1 {
+		return types.NewErrHTTP(http.StatusUnauthorized, "no teams receiver found for app ID")
+	}
+	receiver := teamsReceivers.Items[0]
+
+	if err := t.validator.Validate(req.Context(), token, appID, activity); err != nil {
+		return types.NewErrHTTP(http.StatusUnauthorized, err.Error())
+	}
+
+	if activity.Conversation == nil {
+		return types.NewErrBadRequest("activity has no conversation")
+	}
+
+	if receiver.Spec.Manifest.TenantID != "" && activity.Conversation.TenantID != receiver.Spec.Manifest.TenantID {
+		return types.NewErrHTTP(http.StatusForbidden, "activity is from another tenant")
+	}
+
+	text := teams.MessageText(activity)
+	if activity.Type != "message" || text == "" {
+		req.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	thread, err := getTeamsConversationThread(req, receiver, *activity.Conversation)
+	if err != nil {
+		return err
+	}
+
+	if activity.Conversation.IsGroup && activity.From != nil && activity.From.Name != "" {
+		text = fmt.Sprintf("%s: %s", activity.From.Name, text)
+	}
+
+	data, err := json.Marshal(activity)
+	if err != nil {
+		return err
+	}
+
+	// The Bot Framework expects a response within seconds, so the message is run by the controller after responding.
+	// The message is named after the activity, so that an activity that is sent again is not run again.
+	id := sha256.Sum256([]byte(receiver.Name + "/" + activity.Conversation.ID + "/" + activity.ID))
+	if err := req.Create(&v1.TeamsMessage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      system.TeamsMessagePrefix + hex.EncodeToString(id[:16]),
+			Namespace: req.Namespace(),
+		},
+		Spec: v1.TeamsMessageSpec{
+			ReceiverName: receiver.Name,
+			ThreadName:   thread.Name,
+			ReceivedAt:   metav1.Now(),
+			Input:        text,
+			Activity:     data,
+		},
+	}); apierrors.IsAlreadyExists(err) {
+		req.WriteHeader(http.StatusOK)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	req.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+// getTeamsConversationThread returns the thread of the project for the conversation, and creates it for the first
+// message of the conversation.
+func getTeamsConversationThread(req api.Context, receiver v1.TeamsReceiver, conversation teams.ConversationAccount) (*v1.Thread, error) {
+	var project v1.Thread
+	if err := req.Get(&project, receiver.Spec.ThreadName); err != nil {
+		return nil, err
+	}
+
+	conversationHash := sha256.Sum256([]byte(conversation.ID))
+	threadName := name.SafeHashConcatName(system.ThreadPrefix, receiver.Name, hex.EncodeToString(conversationHash[:])[:16])
+
+	var thread v1.Thread
+	if err := req.Get(&thread, threadName); err == nil {
+		return &thread, nil
+	} else if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	threadDisplayName := "Teams conversation"
+	if conversation.Name != "" {
+		threadDisplayName = "Teams: " + conversation.Name
+	}
+
+	thread = v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       threadName,
+			Namespace:  project.Namespace,
+			Finalizers: []string{v1.ThreadFinalizer},
+		},
+		Spec: v1.ThreadSpec{
+			Manifest: types.ThreadManifest{
+				ThreadManifestManagedFields: types.ThreadManifestManagedFields{
+					Name: threadDisplayName,
+				},
+			},
+			AgentName:        project.Spec.AgentName,
+			ParentThreadName: project.Name,
+			UserID:           project.Spec.UserID,
+		},
+	}
+
+	if err := req.Create(&thread); apierrors.IsAlreadyExists(err) {
+		if err := req.Get(&thread, threadName); err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &thread, nil
+}
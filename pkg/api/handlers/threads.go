@@ -47,6 +47,7 @@ func convertThread(thread v1.Thread) types.Thread {
 			env = append(env, fmt.Sprintf("%s=%s", e.Name, e.Value))
 		}
 	}
+	thread.Spec.Manifest.Notifications = redactNotificationSinks(thread.Spec.Manifest.Notifications)
 	return types.Thread{
 		Metadata:        MetadataFrom(&thread),
 		ThreadManifest:  thread.Spec.Manifest,
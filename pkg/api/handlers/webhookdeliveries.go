@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// unrecordedHeaders are the headers that are not saved with a delivery because they hold credentials.
+var unrecordedHeaders = []string{WebhookTokenHTTPHeader, "Authorization", "Cookie"}
+
+// maxRecordedBodySize is how much of the body of a request is saved with its delivery, so that deliveries, which are
+// kept for days, don't fill the storage. The size and hash are of the whole body.
+const maxRecordedBodySize = 256 * 1024
+
+func newWebhookDelivery(webhook v1.Webhook, header http.Header, body []byte) *v1.WebhookDelivery {
+	headers := make(map[string]string, len(header))
+	for k := range header {
+		if !slices.Contains(unrecordedHeaders, k) {
+			headers[k] = header.Get(k)
+		}
+	}
+
+	recorded := body
+	if len(recorded) > maxRecordedBodySize {
+		recorded = recorded[:maxRecordedBodySize]
+	}
+
+	delivery := &v1.WebhookDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WebhookDeliveryPrefix,
+			Namespace:    webhook.Namespace,
+		},
+		Spec: v1.WebhookDeliverySpec{
+			WebhookName:   webhook.Name,
+			ReceivedAt:    metav1.Now(),
+			Headers:       headers,
+			Body:          recorded,
+			BodyTruncated: len(recorded) < len(body),
+			BodySize:      len(body),
+			BodyHash:      fmt.Sprintf("%x", sha256.Sum256(body)),
+			Result:        types.WebhookDeliveryResultAccepted,
+		},
+	}
+
+	if webhook.Spec.DeliveryIDHeader != "" {
+		delivery.Spec.DeliveryID = header.Get(webhook.Spec.DeliveryIDHeader)
+	}
+
+	return delivery
+}
+
+// rejected returns whether the request of a delivery failed validation of its signature or token.
+func rejected(result types.WebhookDeliveryResult) bool {
+	return result == types.WebhookDeliveryResultInvalidSignature || result == types.WebhookDeliveryResultInvalidToken
+}
+
+// recordDelivery saves the delivery and returns false if a delivery with the same delivery ID was already received,
+// in which case the delivery is replaced with the earlier one.
+// Only deliveries that passed validation are deduplicated, so that invalid requests cannot block a later valid one.
+func recordDelivery(req api.Context, delivery *v1.WebhookDelivery) (bool, error) {
+	if delivery.Spec.DeliveryID != "" && (delivery.Spec.Result == types.WebhookDeliveryResultAccepted || delivery.Spec.Result == types.WebhookDeliveryResultFiltered) {
+		delivery.GenerateName = ""
+		delivery.Name = name.SafeHashConcatName(system.WebhookDeliveryPrefix, delivery.Spec.WebhookName, delivery.Spec.DeliveryID)
+	}
+
+	if err := req.Create(delivery); apierrors.IsAlreadyExists(err) {
+		return false, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			var existing v1.WebhookDelivery
+			if err := req.Get(&existing, delivery.Name); err != nil {
+				return err
+			}
+			existing.Status.Duplicates++
+			if err := req.Storage.Status().Update(req.Context(), &existing); err != nil {
+				return err
+			}
+			*delivery = existing
+			return nil
+		})
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// startDelivery creates an execution of the workflow of the webhook with the input and adds it to the delivery.
+func startDelivery(req api.Context, webhook v1.Webhook, delivery *v1.WebhookDelivery, input string) error {
+	var workflow v1.Workflow
+	if err := req.Get(&workflow, webhook.Spec.WorkflowName); err != nil {
+		return err
+	}
+
+	wfe := &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.WorkflowExecutionPrefix,
+			Namespace:    req.Namespace(),
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			WorkflowName: workflow.Name,
+			WebhookName:  webhook.Name,
+			ThreadName:   webhook.Spec.ThreadName,
+			Input:        input,
+		},
+	}
+	if err := req.Create(wfe); err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := req.Get(delivery, delivery.Name); err != nil {
+			return err
+		}
+		delivery.Status.WorkflowExecutionNames = append(delivery.Status.WorkflowExecutionNames, wfe.Name)
+		return req.Storage.Status().Update(req.Context(), delivery)
+	})
+}
+
+func (a *WebhookHandler) ListDeliveries(req api.Context) error {
+	var webhook v1.Webhook
+	if err := alias.Get(req.Context(), req.Storage, &webhook, req.Namespace(), req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var deliveries v1.WebhookDeliveryList
+	if err := req.List(&deliveries, &kclient.ListOptions{
+		Namespace:     webhook.Namespace,
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.webhookName": webhook.Name}),
+	}); err != nil {
+		return err
+	}
+
+	slices.SortFunc(deliveries.Items, func(a, b v1.WebhookDelivery) int {
+		return b.Spec.ReceivedAt.Compare(a.Spec.ReceivedAt.Time)
+	})
+
+	resp := make([]types.WebhookDelivery, 0, len(deliveries.Items))
+	for _, delivery := range deliveries.Items {
+		resp = append(resp, convertWebhookDelivery(delivery))
+	}
+
+	return req.Write(types.WebhookDeliveryList{Items: resp})
+}
+
+// Redeliver runs the workflow of the webhook again with the payload and headers of an earlier delivery. The filter of
+// the webhook is not checked, so that any accepted delivery can be replayed.
+func (a *WebhookHandler) Redeliver(req api.Context) error {
+	var webhook v1.Webhook
+	if err := alias.Get(req.Context(), req.Storage, &webhook, req.Namespace(), req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var delivery v1.WebhookDelivery
+	if err := req.Get(&delivery, req.PathValue("delivery_id")); err != nil {
+		return err
+	}
+	if delivery.Spec.WebhookName != webhook.Name {
+		return types.NewErrNotFound("delivery %s not found", req.PathValue("delivery_id"))
+	}
+
+	if rejected(delivery.Spec.Result) {
+		return types.NewErrBadRequest("delivery %s was rejected and cannot be redelivered", delivery.Name)
+	}
+	if delivery.Spec.BodyTruncated {
+		return types.NewErrBadRequest("the body of delivery %s was too large to save and it cannot be redelivered", delivery.Name)
+	}
+
+	header := make(http.Header, len(delivery.Spec.Headers))
+	for k, v := range delivery.Spec.Headers {
+		header.Set(k, v)
+	}
+
+	input, err := webhookInput(webhook.Spec.WebhookManifest, header, delivery.Spec.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := startDelivery(req, webhook, &delivery, input); err != nil {
+		return err
+	}
+
+	return req.Write(convertWebhookDelivery(delivery))
+}
+
+func convertWebhookDelivery(delivery v1.WebhookDelivery) types.WebhookDelivery {
+	return types.WebhookDelivery{
+		Metadata:             MetadataFrom(&delivery),
+		WebhookID:            delivery.Spec.WebhookName,
+		DeliveryID:           delivery.Spec.DeliveryID,
+		ReceivedAt:           *types.NewTime(delivery.Spec.ReceivedAt.Time),
+		Headers:              delivery.Spec.Headers,
+		BodySize:             delivery.Spec.BodySize,
+		BodyTruncated:        delivery.Spec.BodyTruncated,
+		BodyHash:             delivery.Spec.BodyHash,
+		Result:               delivery.Spec.Result,
+		WorkflowExecutionIDs: delivery.Status.WorkflowExecutionNames,
+		Duplicates:           delivery.Status.Duplicates,
+	}
+}
@@ -10,15 +10,20 @@ import (
 	"net/textproto"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/alias"
 	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/expression"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
+	"github.com/sethvargo/go-limiter"
+	"github.com/sethvargo/go-limiter/memorystore"
 	"golang.org/x/crypto/bcrypt"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -26,10 +31,23 @@ const (
 	WebhookTokenQueryParam = "token"
 )
 
-type WebhookHandler struct{}
+// rejectedDeliveriesPerMinute is the number of rejected requests to a webhook that are recorded each minute. Requests
+// with an invalid signature or token beyond this get a 429 response and are not recorded.
+const rejectedDeliveriesPerMinute = 10
+
+type WebhookHandler struct {
+	rejectedDeliveries limiter.Store
+}
 
 func NewWebhookHandler() *WebhookHandler {
-	return new(WebhookHandler)
+	// memorystore.New does not return an error.
+	rejectedDeliveries, _ := memorystore.New(&memorystore.Config{
+		Tokens:   rejectedDeliveriesPerMinute,
+		Interval: time.Minute,
+	})
+	return &WebhookHandler{
+		rejectedDeliveries: rejectedDeliveries,
+	}
 }
 
 type webhookRequest struct {
@@ -52,7 +70,7 @@ func (a *WebhookHandler) Update(req api.Context) error {
 		return err
 	}
 
-	if webhookReq.ValidationHeader != "" && webhookReq.Secret == "" {
+	if signatureHeader(webhookReq.WebhookManifest) != "" && webhookReq.Secret == "" {
 		webhookReq.Secret = wh.Spec.Secret
 	}
 
@@ -222,23 +240,128 @@ func (a *WebhookHandler) Execute(req api.Context) error {
 		return fmt.Errorf("failed to read request body: %w", err)
 	}
 
-	if webhook.Spec.ValidationHeader != "" {
-		if err = validateSecretHeader(webhook.Spec.Secret, body, req.Request.Header.Values(webhook.Spec.ValidationHeader)); err != nil {
-			req.WriteHeader(http.StatusForbidden)
-			return nil
+	delivery := newWebhookDelivery(webhook, req.Request.Header, body)
+	if webhook.Spec.Synchronous {
+		if delivery.Spec.ResultKey, err = newResultKey(); err != nil {
+			return err
+		}
+	}
+
+	if signatureHeader(webhook.Spec.WebhookManifest) != "" {
+		if err = validateSignature(webhook.Spec.WebhookManifest, req.Request.Header, body, time.Now()); err != nil {
+			log.Debugf("Rejecting request to webhook %s: %v", webhook.Name, err)
+			delivery.Spec.Result = types.WebhookDeliveryResultInvalidSignature
 		}
 	}
 
-	if webhook.Spec.TokenHash != nil {
+	if webhook.Spec.TokenHash != nil && delivery.Spec.Result == types.WebhookDeliveryResultAccepted {
 		password := req.Request.Header.Get(WebhookTokenHTTPHeader)
 		if password == "" {
 			password = req.Request.URL.Query().Get(WebhookTokenQueryParam)
 		}
 
 		if err := bcrypt.CompareHashAndPassword(webhook.Spec.TokenHash, []byte(password)); err != nil {
-			req.WriteHeader(http.StatusForbidden)
-			return nil
+			delivery.Spec.Result = types.WebhookDeliveryResultInvalidToken
+		}
+	}
+
+	if delivery.Spec.Result == types.WebhookDeliveryResultAccepted {
+		matched, err := matchesFilter(webhook.Spec.WebhookManifest, req.Request.Header, body)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			delivery.Spec.Result = types.WebhookDeliveryResultFiltered
+		}
+	}
+
+	if rejected(delivery.Spec.Result) {
+		if _, _, _, ok, err := a.rejectedDeliveries.Take(req.Context(), webhook.Namespace+"/"+webhook.Name); err != nil {
+			return err
+		} else if !ok {
+			return types.NewErrHTTP(http.StatusTooManyRequests, "too many rejected requests")
+		}
+
+		// The body of a rejected request is not trusted, so only its size and hash are recorded.
+		delivery.Spec.Body = nil
+		if _, err := recordDelivery(req, delivery); err != nil {
+			return err
+		}
+
+		req.WriteHeader(http.StatusForbidden)
+		return nil
+	}
+
+	isNew, err := recordDelivery(req, delivery)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !isNew && webhook.Spec.Synchronous:
+		// Respond to a duplicate with the output of the run of the original delivery.
+		return respondSynchronously(req, webhook, delivery)
+	case !isNew || delivery.Spec.Result == types.WebhookDeliveryResultFiltered:
+		req.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	input, err := webhookInput(webhook.Spec.WebhookManifest, req.Request.Header, body)
+	if err != nil {
+		return err
+	}
+
+	if err := startDelivery(req, webhook, delivery, input); err != nil {
+		// Delete the delivery so that it is not taken as a duplicate when the sender retries it.
+		if deleteErr := req.Delete(delivery); kclient.IgnoreNotFound(deleteErr) != nil {
+			log.Errorf("Failed to delete delivery %s of webhook %s that could not be started: %v", delivery.Name, webhook.Name, deleteErr)
 		}
+		return err
+	}
+
+	if webhook.Spec.Synchronous {
+		return respondSynchronously(req, webhook, delivery)
+	}
+
+	req.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// matchesFilter returns whether the request matches the filter of the webhook.
+func matchesFilter(manifest types.WebhookManifest, header http.Header, body []byte) (bool, error) {
+	if manifest.Filter == "" {
+		return true, nil
+	}
+
+	value, err := expression.Evaluate(manifest.Filter, func(ref string) (string, error) {
+		return webhookReference(ref, header, body)
+	})
+	if err != nil {
+		return false, types.NewErrBadRequest("failed to evaluate filter: %v", err)
+	}
+	return expression.IsTrue(value), nil
+}
+
+// webhookInput returns the input of the workflow for the request.
+func webhookInput(manifest types.WebhookManifest, header http.Header, body []byte) (string, error) {
+	if len(manifest.Params) > 0 {
+		resolve := func(ref string) (string, error) {
+			return webhookReference(ref, header, body)
+		}
+		params := make(map[string]string, len(manifest.Params))
+		for param, expr := range manifest.Params {
+			value, err := expression.Evaluate(expr, resolve)
+			if err != nil {
+				return "", types.NewErrBadRequest("invalid value for parameter %s: %v", param, err)
+			}
+			params[param] = value
+		}
+
+		data, err := json.Marshal(params)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal input: %w", err)
+		}
+		return string(data), nil
 	}
 
 	var input struct {
@@ -251,41 +374,52 @@ func (a *WebhookHandler) Execute(req api.Context) error {
 	input.Payload = string(body)
 	input.Headers = make(map[string]string)
 
-	allHeaders := slices.Contains(webhook.Spec.Headers, "*")
-	for k := range req.Request.Header {
-		if !allHeaders && !slices.Contains(webhook.Spec.Headers, k) {
+	allHeaders := slices.Contains(manifest.Headers, "*")
+	for k := range header {
+		if !allHeaders && !slices.Contains(manifest.Headers, k) {
 			continue
 		}
 
-		input.Headers[k] = req.Request.Header.Get(k)
+		input.Headers[k] = header.Get(k)
 	}
 
-	inputText, err := json.Marshal(input)
+	data, err := json.Marshal(input)
 	if err != nil {
-		return fmt.Errorf("failed to marshal input: %w", err)
+		return "", fmt.Errorf("failed to marshal input: %w", err)
 	}
+	return string(data), nil
+}
 
-	var workflow v1.Workflow
-	if err := req.Get(&workflow, webhook.Spec.WorkflowName); err != nil {
-		return err
+// webhookReference looks up a reference used in the filter or parameters of a webhook: payload, optionally followed
+// by a path into the JSON body, or headers.<name>.
+func webhookReference(ref string, header http.Header, body []byte) (string, error) {
+	switch {
+	case ref == "payload" || strings.HasPrefix(ref, "payload."):
+		return expression.LookupPath(string(body), strings.TrimPrefix(strings.TrimPrefix(ref, "payload"), "."))
+	case strings.HasPrefix(ref, "headers."):
+		return header.Get(strings.TrimPrefix(ref, "headers.")), nil
+	default:
+		return "", fmt.Errorf("unknown reference %q: expected a quoted string, payload, or headers.<name>", ref)
 	}
+}
 
-	if err = req.Create(&v1.WorkflowExecution{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: system.WorkflowExecutionPrefix,
-			Namespace:    req.Namespace(),
-		},
-		Spec: v1.WorkflowExecutionSpec{
-			WorkflowName: workflow.Name,
-			WebhookName:  webhook.Name,
-			ThreadName:   webhook.Spec.ThreadName,
-			Input:        string(inputText),
-		},
-	}); err != nil && !apierrors.IsAlreadyExists(err) {
-		return err
+// validateWebhookExpressions checks that the filter and parameters of a webhook are valid expressions.
+func validateWebhookExpressions(filter string, params map[string]string) error {
+	resolve := func(ref string) (string, error) {
+		return webhookReference(ref, nil, nil)
+	}
+
+	if filter != "" {
+		if _, err := expression.Evaluate(filter, resolve); err != nil {
+			return types.NewErrBadRequest("invalid filter: %v", err)
+		}
+	}
+	for param, expr := range params {
+		if _, err := expression.Evaluate(expr, resolve); err != nil {
+			return types.NewErrBadRequest("invalid value for parameter %s: %v", param, err)
+		}
 	}
 
-	req.WriteHeader(http.StatusNoContent)
 	return nil
 }
 
@@ -322,10 +456,19 @@ func validateManifest(req api.Context, manifest types.WebhookManifest) error {
 		return err
 	}
 
-	// On creation, the user must set both the validation header and secret or set neither.
-	if (manifest.ValidationHeader != "") != (manifest.Secret != "") {
+	// On creation, the user must set both the validation header and secret or set neither. Signature presets
+	// default to the header of the provider.
+	if manifest.SignaturePreset == "" && (manifest.ValidationHeader != "") != (manifest.Secret != "") {
 		return apierrors.NewBadRequest("webhook must have secret and header set together")
 	}
 
-	return nil
+	if err := validateWebhookExpressions(manifest.Filter, manifest.Params); err != nil {
+		return err
+	}
+
+	if _, err := synchronousTimeout(manifest.SynchronousTimeout); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	return validateSignatureOptions(manifest)
 }
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const defaultSignatureTolerance = 5 * time.Minute
+
+// signatureHeaders are the headers that providers put their signatures in, for presets that have one.
+var signatureHeaders = map[types.WebhookSignaturePreset]string{
+	types.WebhookSignatureGitHub:  "X-Hub-Signature-256",
+	types.WebhookSignatureStripe:  "Stripe-Signature",
+	types.WebhookSignatureSlack:   "X-Slack-Signature",
+	types.WebhookSignatureShopify: "X-Shopify-Hmac-Sha256",
+}
+
+// signatureHeader returns the header that the signature of requests to the webhook is in, or an empty string if the
+// requests are not signed.
+func signatureHeader(manifest types.WebhookManifest) string {
+	if manifest.ValidationHeader != "" {
+		return manifest.ValidationHeader
+	}
+	return signatureHeaders[manifest.SignaturePreset]
+}
+
+// validateSignature checks the signature of the request against the secret of the webhook, in the format of its
+// preset. For presets that sign a timestamp, requests that are older than the tolerance are rejected.
+func validateSignature(manifest types.WebhookManifest, header http.Header, body []byte, now time.Time) error {
+	values := header.Values(signatureHeader(manifest))
+	if len(values) == 0 {
+		return fmt.Errorf("missing signature header")
+	}
+
+	tolerance, err := signatureTolerance(manifest.SignatureTolerance)
+	if err != nil {
+		return err
+	}
+
+	secret := []byte(manifest.Secret)
+	switch manifest.SignaturePreset {
+	case "":
+		return validateSecretHeader(manifest.Secret, body, values)
+	case types.WebhookSignatureGitHub:
+		return checkSignatures(sha256.New, secret, body, prefixedHex(values, "sha256="))
+	case types.WebhookSignatureStripe:
+		return validateStripeSignature(secret, body, values, now, tolerance)
+	case types.WebhookSignatureSlack:
+		timestamp := header.Get("X-Slack-Request-Timestamp")
+		if err := checkTimestamp(timestamp, now, tolerance); err != nil {
+			return err
+		}
+		return checkSignatures(sha256.New, secret, []byte("v0:"+timestamp+":"+string(body)), prefixedHex(values, "v0="))
+	case types.WebhookSignatureShopify:
+		return checkSignatures(sha256.New, secret, body, decodeAll(values, base64.StdEncoding.DecodeString))
+	case types.WebhookSignatureHMACSHA1:
+		return checkSignatures(sha1.New, secret, body, hexOrBase64(values))
+	case types.WebhookSignatureHMACSHA256:
+		return checkSignatures(sha256.New, secret, body, hexOrBase64(values))
+	case types.WebhookSignatureHMACSHA512:
+		return checkSignatures(sha512.New, secret, body, hexOrBase64(values))
+	default:
+		return fmt.Errorf("unknown signature preset %q", manifest.SignaturePreset)
+	}
+}
+
+// validateStripeSignature checks a signature in the form "t=<timestamp>,v1=<signature>,v1=<signature>", where each
+// signature is of "<timestamp>.<body>".
+func validateStripeSignature(secret, body []byte, values []string, now time.Time, tolerance time.Duration) error {
+	var (
+		timestamp  string
+		signatures []string
+	)
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+			switch key {
+			case "t":
+				timestamp = val
+			case "v1":
+				signatures = append(signatures, val)
+			}
+		}
+	}
+
+	if err := checkTimestamp(timestamp, now, tolerance); err != nil {
+		return err
+	}
+	return checkSignatures(sha256.New, secret, []byte(timestamp+"."+string(body)), decodeAll(signatures, hex.DecodeString))
+}
+
+func checkSignatures(newHash func() hash.Hash, secret, message []byte, signatures [][]byte) error {
+	h := hmac.New(newHash, secret)
+	_, _ = h.Write(message)
+	sum := h.Sum(nil)
+
+	for _, signature := range signatures {
+		if hmac.Equal(sum, signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid signature")
+}
+
+// checkTimestamp checks that the Unix timestamp is within the tolerance of now, so that old requests cannot be
+// replayed.
+func checkTimestamp(timestamp string, now time.Time, tolerance time.Duration) error {
+	seconds, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid signature timestamp %q", timestamp)
+	}
+
+	if age := now.Sub(time.Unix(seconds, 0)).Abs(); age > tolerance {
+		return fmt.Errorf("signature timestamp is outside the tolerance of %s", tolerance)
+	}
+
+	return nil
+}
+
+func signatureTolerance(tolerance string) (time.Duration, error) {
+	if tolerance == "" {
+		return defaultSignatureTolerance, nil
+	}
+
+	d, err := time.ParseDuration(tolerance)
+	if err != nil {
+		return 0, fmt.Errorf("invalid signature tolerance %q: %w", tolerance, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("signature tolerance must be positive")
+	}
+	return d, nil
+}
+
+// prefixedHex decodes the hex signatures that have the prefix, of the comma-separated signatures in the values.
+func prefixedHex(values []string, prefix string) [][]byte {
+	var signatures []string
+	for _, v := range values {
+		for _, val := range strings.Split(v, ",") {
+			if val, ok := strings.CutPrefix(strings.TrimSpace(val), prefix); ok {
+				signatures = append(signatures, val)
+			}
+		}
+	}
+	return decodeAll(signatures, hex.DecodeString)
+}
+
+// hexOrBase64 decodes the hex or base64 signatures of the comma-separated signatures in the values, removing any
+// prefix such as "sha1=".
+func hexOrBase64(values []string) [][]byte {
+	var signatures []string
+	for _, v := range values {
+		for _, val := range strings.Split(v, ",") {
+			val = strings.TrimSpace(val)
+			if prefix, rest, ok := strings.Cut(val, "="); ok && strings.HasPrefix(strings.ToLower(prefix), "sha") {
+				val = rest
+			}
+			signatures = append(signatures, val)
+		}
+	}
+
+	return append(decodeAll(signatures, hex.DecodeString), decodeAll(signatures, base64.StdEncoding.DecodeString)...)
+}
+
+func decodeAll(values []string, decode func(string) ([]byte, error)) [][]byte {
+	var result [][]byte
+	for _, v := range values {
+		if b, err := decode(strings.TrimSpace(v)); err == nil {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// validateSignatureOptions checks the signature options of the manifest.
+func validateSignatureOptions(manifest types.WebhookManifest) error {
+	switch manifest.SignaturePreset {
+	case "", types.WebhookSignatureGitHub, types.WebhookSignatureStripe, types.WebhookSignatureSlack, types.WebhookSignatureShopify,
+		types.WebhookSignatureHMACSHA1, types.WebhookSignatureHMACSHA256, types.WebhookSignatureHMACSHA512:
+	default:
+		return types.NewErrBadRequest("unknown signature preset %q", manifest.SignaturePreset)
+	}
+
+	if _, err := signatureTolerance(manifest.SignatureTolerance); err != nil {
+		return types.NewErrBadRequest("%v", err)
+	}
+
+	if manifest.SignaturePreset == "" {
+		return nil
+	}
+	if manifest.Secret == "" {
+		return types.NewErrBadRequest("webhook with a signature preset must have a secret")
+	}
+	if signatureHeader(manifest) == "" {
+		return types.NewErrBadRequest("webhook with signature preset %q must have a validation header", manifest.SignaturePreset)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/api"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/wait"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultSynchronousTimeout = 30 * time.Second
+	maxSynchronousTimeout     = 5 * time.Minute
+)
+
+func synchronousTimeout(timeout string) (time.Duration, error) {
+	if timeout == "" {
+		return defaultSynchronousTimeout, nil
+	}
+
+	d, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid synchronous timeout %q: %w", timeout, err)
+	}
+	if d <= 0 || d > maxSynchronousTimeout {
+		return 0, fmt.Errorf("synchronous timeout must be positive and at most %s", maxSynchronousTimeout)
+	}
+	return d, nil
+}
+
+func newResultKey() (string, error) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("failed to generate result key: %w", err)
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// respondSynchronously waits for the last execution of the delivery to finish and writes its output. If it does not
+// finish within the timeout of the webhook, it responds with 202 and the URL to get the output from later.
+func respondSynchronously(req api.Context, webhook v1.Webhook, delivery *v1.WebhookDelivery) error {
+	if len(delivery.Status.WorkflowExecutionNames) == 0 {
+		req.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	// The timeout was validated when the webhook was saved.
+	timeout, _ := synchronousTimeout(webhook.Spec.SynchronousTimeout)
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	defer cancel()
+
+	wfe, err := wait.For(ctx, req.Storage, &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      delivery.Status.WorkflowExecutionNames[len(delivery.Status.WorkflowExecutionNames)-1],
+			Namespace: delivery.Namespace,
+		},
+	}, func(wfe *v1.WorkflowExecution) (bool, error) {
+		return wfe.Status.State.IsTerminal(), nil
+	}, wait.Option{Timeout: timeout})
+	if apierrors.IsNotFound(err) {
+		return err
+	} else if err != nil {
+		log.Debugf("Run for delivery %s of webhook %s did not finish in time: %v", delivery.Name, webhook.Name, err)
+		return writePending(req, delivery, types.WorkflowStateRunning)
+	}
+
+	return writeExecutionResult(req, delivery, wfe)
+}
+
+// Result returns the output of the last execution of a delivery to a synchronous webhook. The key of the delivery
+// is required, because the webhook endpoints are not authenticated.
+func (a *WebhookHandler) Result(req api.Context) error {
+	var webhook v1.Webhook
+	if err := alias.Get(req.Context(), req.Storage, &webhook, req.PathValue("namespace"), req.PathValue("id")); err != nil {
+		return err
+	}
+
+	var delivery v1.WebhookDelivery
+	if err := req.Storage.Get(req.Context(), router.Key(webhook.Namespace, req.PathValue("delivery_id")), &delivery); apierrors.IsNotFound(err) {
+		return types.NewErrNotFound("delivery %s not found", req.PathValue("delivery_id"))
+	} else if err != nil {
+		return err
+	}
+
+	key := req.URL.Query().Get("key")
+	if delivery.Spec.WebhookName != webhook.Name || delivery.Spec.ResultKey == "" ||
+		subtle.ConstantTimeCompare([]byte(delivery.Spec.ResultKey), []byte(key)) != 1 {
+		return types.NewErrNotFound("delivery %s not found", req.PathValue("delivery_id"))
+	}
+
+	if len(delivery.Status.WorkflowExecutionNames) == 0 {
+		req.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := req.Storage.Get(req.Context(), router.Key(delivery.Namespace, delivery.Status.WorkflowExecutionNames[len(delivery.Status.WorkflowExecutionNames)-1]), &wfe); err != nil {
+		return err
+	}
+
+	if !wfe.Status.State.IsTerminal() {
+		return writePending(req, &delivery, wfe.Status.State)
+	}
+
+	return writeExecutionResult(req, &delivery, &wfe)
+}
+
+func writeExecutionResult(req api.Context, delivery *v1.WebhookDelivery, wfe *v1.WorkflowExecution) error {
+	if wfe.Status.State != types.WorkflowStateComplete {
+		return types.NewErrHTTP(http.StatusInternalServerError, fmt.Sprintf("run %s failed: %s", wfe.Name, wfe.Status.Error))
+	}
+
+	req.ResponseWriter.Header().Set("X-Obot-Webhook-Delivery-ID", delivery.Name)
+	if json.Valid([]byte(wfe.Status.Output)) {
+		req.ResponseWriter.Header().Set("Content-Type", "application/json")
+	} else {
+		req.ResponseWriter.Header().Set("Content-Type", "text/plain")
+	}
+	_, err := req.ResponseWriter.Write([]byte(wfe.Status.Output))
+	return err
+}
+
+func writePending(req api.Context, delivery *v1.WebhookDelivery, state types.WorkflowState) error {
+	if state == "" {
+		state = types.WorkflowStatePending
+	}
+
+	statusURL := fmt.Sprintf("%s/webhooks/%s/%s/deliveries/%s/result?key=%s", req.APIBaseURL, delivery.Namespace,
+		req.PathValue("id"), delivery.Name, delivery.Spec.ResultKey)
+
+	req.ResponseWriter.Header().Set("Location", statusURL)
+	req.ResponseWriter.Header().Set("Content-Type", "application/json")
+	req.WriteHeader(http.StatusAccepted)
+	return json.NewEncoder(req.ResponseWriter).Encode(types.WebhookPendingResponse{
+		DeliveryID:          delivery.Name,
+		WorkflowExecutionID: delivery.Status.WorkflowExecutionNames[len(delivery.Status.WorkflowExecutionNames)-1],
+		State:               state,
+		StatusURL:           statusURL,
+	})
+}
@@ -116,6 +116,7 @@ func (a *WorkflowHandler) Delete(req api.Context) error {
 }
 
 func convertWorkflow(workflow v1.Workflow) (*types.Workflow, error) {
+	workflow.Spec.Manifest.Notifications = redactNotificationSinks(workflow.Spec.Manifest.Notifications)
 	return &types.Workflow{
 		Metadata:         MetadataFrom(&workflow),
 		WorkflowManifest: workflow.Spec.Manifest,
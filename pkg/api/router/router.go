@@ -41,9 +41,11 @@ func Router(services *services.Services) (http.Handler, error) {
 	memories := handlers.NewMemoryHandler()
 	workflows := handlers.NewWorkflowHandler(services.GPTClient, services.ServerURL, services.Invoker)
 	slackEventHandler := handlers.NewSlackEventHandler(services.GPTClient)
-	sendgridWebhookHandler := sendgrid.NewInboundWebhookHandler(services.StorageClient, services.EmailServerName, services.SendgridWebhookUsername, services.SendgridWebhookPassword)
+	sendgridWebhookHandler := sendgrid.NewInboundWebhookHandler(services.StorageClient, services.Invoker, services.GPTClient, services.ProviderDispatcher, services.WorkspaceProviderType, services.EmailServerName, services.SendgridWebhookUsername, services.SendgridWebhookPassword)
 	images := handlers.NewImageHandler(services.GatewayClient, services.GeminiClient)
 	slackHandler := handlers.NewSlackHandler(services.GPTClient)
+	teamsHandler := handlers.NewTeamsHandler()
+	notifications := handlers.NewNotificationHandler()
 	mcp := handlers.NewMCPHandler()
 
 	// Version
@@ -141,6 +143,15 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("PUT /api/assistants/{assistant_id}/projects/{project_id}/slack", slackHandler.Update)
 	mux.HandleFunc("DELETE /api/assistants/{assistant_id}/projects/{project_id}/slack", slackHandler.Delete)
 
+	// Project notifications
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/notifications", notifications.ListDeliveries)
+
+	// Project Teams integration
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/teams", teamsHandler.Get)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/teams", teamsHandler.Create)
+	mux.HandleFunc("PUT /api/assistants/{assistant_id}/projects/{project_id}/teams", teamsHandler.Update)
+	mux.HandleFunc("DELETE /api/assistants/{assistant_id}/projects/{project_id}/teams", teamsHandler.Delete)
+
 	// Top level Tasks
 	mux.HandleFunc("GET /api/tasks", tasks.List)
 	mux.HandleFunc("DELETE /api/tasks/{id}", tasks.Delete)
@@ -167,7 +178,11 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("PUT /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}", tasks.UpdateFromScope)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/run", tasks.RunFromScope)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/steps/{step_id}/run", tasks.RunFromScope)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/steps/{step_id}/approve", tasks.ApproveStepFromScope)
+	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/replay", tasks.ReplayRunFromScope)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/diff/{other_run_id}", tasks.DiffRunsFromScope)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs", tasks.ListRunsFromScope)
+	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/notifications", notifications.ListDeliveries)
 	mux.HandleFunc("DELETE /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}", tasks.DeleteRunFromScope)
 	mux.HandleFunc("GET /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}", tasks.GetRunFromScope)
 	mux.HandleFunc("POST /api/assistants/{assistant_id}/projects/{project_id}/tasks/{id}/runs/{run_id}/abort", tasks.AbortRunFromScope)
@@ -340,7 +355,10 @@ func Router(services *services.Services) (http.Handler, error) {
 	mux.HandleFunc("DELETE /api/webhooks/{id}", webhooks.Delete)
 	mux.HandleFunc("PUT /api/webhooks/{id}", webhooks.Update)
 	mux.HandleFunc("POST /api/webhooks/{id}/remove-token", webhooks.RemoveToken)
+	mux.HandleFunc("GET /api/webhooks/{id}/deliveries", webhooks.ListDeliveries)
+	mux.HandleFunc("POST /api/webhooks/{id}/deliveries/{delivery_id}/redeliver", webhooks.Redeliver)
 	mux.HandleFunc("POST /api/webhooks/{namespace}/{id}", webhooks.Execute)
+	mux.HandleFunc("GET /api/webhooks/{namespace}/{id}/deliveries/{delivery_id}/result", webhooks.Result)
 
 	// Webhook for third party integration to trigger workflow
 	mux.HandleFunc("POST /api/sendgrid", sendgridWebhookHandler.InboundWebhookHandler)
@@ -362,6 +380,11 @@ func Router(services *services.Services) (http.Handler, error) {
 
 	// Slack event receiver
 	mux.HandleFunc("POST /api/slack/events", slackEventHandler.HandleEvent)
+	mux.HandleFunc("POST /api/slack/commands", slackEventHandler.HandleCommand)
+	mux.HandleFunc("POST /api/slack/interactions", slackEventHandler.HandleInteraction)
+
+	// Teams activity receiver
+	mux.HandleFunc("POST /api/teams/messages", teamsHandler.HandleActivity)
 
 	// MCP Catalog
 	mux.HandleFunc("GET /api/mcp/catalog", mcp.ListCatalog)
@@ -2,6 +2,7 @@ package cronjob
 
 import (
 	"fmt"
+	"hash/fnv"
 	"time"
 
 	"github.com/adhocore/gronx"
@@ -46,18 +47,97 @@ func GetScheduleAndTimezone(cronJob v1.CronJob) (string, string) {
 	return cronJob.Spec.Schedule, ""
 }
 
+// missedTickGracePeriod is how late a tick of the schedule can be run before it is considered missed.
+const missedTickGracePeriod = 5 * time.Minute
+
+func GetScheduleOptions(cronJob v1.CronJob) types.ScheduleOptions {
+	if cronJob.Spec.TaskSchedule != nil {
+		return cronJob.Spec.TaskSchedule.ScheduleOptions
+	}
+	return cronJob.Spec.ScheduleOptions
+}
+
+// ValidateScheduleOptions returns an error if the options cannot be used to schedule runs.
+func ValidateScheduleOptions(options types.ScheduleOptions) error {
+	switch options.CatchUp {
+	case "", types.CatchUpPolicySkip, types.CatchUpPolicyRunOnce, types.CatchUpPolicyRunAll:
+	default:
+		return fmt.Errorf("invalid catch up policy %q: must be skip, runOnce, or runAll", options.CatchUp)
+	}
+	if options.Jitter != "" {
+		if jitter, err := time.ParseDuration(options.Jitter); err != nil {
+			return fmt.Errorf("invalid jitter %q: %w", options.Jitter, err)
+		} else if jitter < 0 {
+			return fmt.Errorf("invalid jitter %q: must not be negative", options.Jitter)
+		}
+	}
+	for _, blackout := range options.Blackouts {
+		start, err := time.Parse(time.DateOnly, blackout.Start)
+		if err != nil {
+			return fmt.Errorf("invalid blackout start %q: %w", blackout.Start, err)
+		}
+		end, err := time.Parse(time.DateOnly, blackout.End)
+		if err != nil {
+			return fmt.Errorf("invalid blackout end %q: %w", blackout.End, err)
+		}
+		if end.Before(start) {
+			return fmt.Errorf("invalid blackout %s to %s: end is before start", blackout.Start, blackout.End)
+		}
+	}
+	if options.EndAfterRuns < 0 {
+		return fmt.Errorf("invalid end after runs %d: must not be negative", options.EndAfterRuns)
+	}
+	if options.EndAfter != "" {
+		if _, err := time.Parse(time.DateOnly, options.EndAfter); err != nil {
+			return fmt.Errorf("invalid end after date %q: %w", options.EndAfter, err)
+		}
+	}
+	return nil
+}
+
 func (h *Handler) Run(req router.Request, resp router.Response) error {
 	cj := req.Object.(*v1.CronJob)
+	options := GetScheduleOptions(*cj)
+
+	if options.EndAfterRuns > 0 && cj.Status.RunCount >= options.EndAfterRuns {
+		return nil
+	}
+
 	next, err := calculateNextRunTime(*cj)
 	if err != nil {
 		return fmt.Errorf("failed to calculate next run time: %w", err)
 	}
 
-	if until := time.Until(next); until > 0 {
+	if ended(*cj, next) {
+		return nil
+	}
+
+	if until := time.Until(next.Add(jitter(*cj, next))); until > 0 {
 		resp.RetryAfter(until)
 		return nil
 	}
 
+	if options.CatchUp != types.CatchUpPolicyRunAll {
+		// Move on to the latest tick that is due so that the ticks before it are not run.
+		latest, err := latestTick(*cj, next, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to calculate next run time: %w", err)
+		}
+
+		late := time.Since(latest.Add(jitter(*cj, latest)))
+		if late < 0 {
+			resp.RetryAfter(-late)
+			return nil
+		}
+
+		if options.CatchUp == types.CatchUpPolicySkip && late > missedTickGracePeriod {
+			cj.Status.LastScheduledTime = &metav1.Time{Time: latest}
+			return nil
+		}
+
+		next = latest
+	}
+
 	var workflow v1.Workflow
 	if err := req.Get(&workflow, cj.Namespace, cj.Spec.WorkflowName); apierror.IsNotFound(err) {
 		return nil
@@ -83,35 +163,116 @@ func (h *Handler) Run(req router.Request, resp router.Response) error {
 	}
 
 	cj.Status.LastRunStartedAt = &[]metav1.Time{metav1.Now()}[0]
+	cj.Status.LastScheduledTime = &metav1.Time{Time: next}
+	cj.Status.RunCount++
 	return nil
 }
 
+// calculateNextRunTime returns the next tick of the schedule after the last one that was run or skipped, not
+// including ticks that fall in a blackout.
 func calculateNextRunTime(cronJob v1.CronJob) (time.Time, error) {
-	lastRun := cronJob.Status.LastRunStartedAt
+	lastRun := cronJob.Status.LastScheduledTime
+	if lastRun.IsZero() {
+		lastRun = cronJob.Status.LastRunStartedAt
+	}
 	if lastRun.IsZero() {
 		lastRun = &metav1.Time{Time: cronJob.CreationTimestamp.Time}
 	}
 
-	schedule, timezone := GetScheduleAndTimezone(cronJob)
-	var location *time.Location
-	if timezone != "" {
-		loc, err := time.LoadLocation(timezone)
-		if err == nil {
-			location = loc
+	return nextTick(cronJob, lastRun.Time)
+}
+
+// nextTick returns the first tick of the schedule after the given time that is not in a blackout. A tick in a
+// blackout moves the search to the end of the blackout, so that the ticks in it are not looked at one by one.
+func nextTick(cronJob v1.CronJob, after time.Time) (time.Time, error) {
+	schedule, _ := GetScheduleAndTimezone(cronJob)
+	if location := scheduleLocation(cronJob); location != nil {
+		after = after.In(location)
+	}
+
+	next, err := gronx.NextTickAfter(schedule, after, false)
+	for err == nil {
+		blackout := findBlackout(cronJob, next)
+		if blackout == nil {
+			return next, nil
 		}
+		// The blackout ends at the start of the day after its end date.
+		end, _ := time.ParseInLocation(time.DateOnly, blackout.End, next.Location())
+		next, err = gronx.NextTickAfter(schedule, end.AddDate(0, 0, 1), true)
 	}
-	if location != nil {
-		lastRun = &metav1.Time{Time: lastRun.In(location)}
+	return time.Time{}, fmt.Errorf("failed to parse schedule: %w", err)
+}
+
+// latestTick returns the last tick of the schedule that is not after now and not in a blackout, or next if there is
+// no such tick after it.
+func latestTick(cronJob v1.CronJob, next, now time.Time) (time.Time, error) {
+	schedule, _ := GetScheduleAndTimezone(cronJob)
+	if location := scheduleLocation(cronJob); location != nil {
+		now = now.In(location)
 	}
 
-	next, err := gronx.NextTickAfter(schedule, lastRun.Time, false)
+	// Ticks are on the minute, and a reference time that is due is returned as is.
+	latest, err := gronx.PrevTickBefore(schedule, now.Truncate(time.Minute), true)
+	for err == nil && latest.After(next) {
+		blackout := findBlackout(cronJob, latest)
+		if blackout == nil {
+			return latest, nil
+		}
+		start, _ := time.ParseInLocation(time.DateOnly, blackout.Start, latest.Location())
+		latest, err = gronx.PrevTickBefore(schedule, start, false)
+	}
 	if err != nil {
 		return time.Time{}, fmt.Errorf("failed to parse schedule: %w", err)
 	}
-
 	return next, nil
 }
 
+func scheduleLocation(cronJob v1.CronJob) *time.Location {
+	if _, timezone := GetScheduleAndTimezone(cronJob); timezone != "" {
+		if loc, err := time.LoadLocation(timezone); err == nil {
+			return loc
+		}
+	}
+	return nil
+}
+
+// scheduleDate returns the date of the tick in the time zone of the schedule.
+func scheduleDate(cronJob v1.CronJob, tick time.Time) string {
+	if location := scheduleLocation(cronJob); location != nil {
+		tick = tick.In(location)
+	}
+	return tick.Format(time.DateOnly)
+}
+
+// findBlackout returns the blackout that the tick falls in, or nil if it is not in one.
+func findBlackout(cronJob v1.CronJob, tick time.Time) *types.ScheduleBlackout {
+	date := scheduleDate(cronJob, tick)
+	for _, blackout := range GetScheduleOptions(cronJob).Blackouts {
+		if date >= blackout.Start && date <= blackout.End {
+			return &blackout
+		}
+	}
+	return nil
+}
+
+func ended(cronJob v1.CronJob, tick time.Time) bool {
+	endAfter := GetScheduleOptions(cronJob).EndAfter
+	return endAfter != "" && scheduleDate(cronJob, tick) > endAfter
+}
+
+// jitter returns the delay of the run for the tick. The delay is random but the same every time it is calculated
+// for the same tick so that it does not change each time the cron job is processed.
+func jitter(cronJob v1.CronJob, tick time.Time) time.Duration {
+	maxJitter, err := time.ParseDuration(GetScheduleOptions(cronJob).Jitter)
+	if err != nil || maxJitter <= 0 {
+		return 0
+	}
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s/%s/%d", cronJob.Namespace, cronJob.Name, tick.Unix())
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
+
 func (h *Handler) SetSuccessRunTime(req router.Request, _ router.Response) error {
 	cj := req.Object.(*v1.CronJob)
 
@@ -70,3 +70,141 @@ func TestCalculateNextRunTime(t *testing.T) {
 		require.Equal(t, expectedNextRun, nextRun)
 	})
 }
+
+func TestCalculateNextRunTimeBlackouts(t *testing.T) {
+	lastRun := time.Date(2025, 12, 23, 10, 0, 0, 0, time.UTC)
+	cronJob := v1.CronJob{
+		Status: v1.CronJobStatus{
+			LastScheduledTime: &metav1.Time{Time: lastRun},
+		},
+		Spec: v1.CronJobSpec{
+			CronJobManifest: types.CronJobManifest{
+				TaskSchedule: &types.Schedule{
+					Interval: "daily",
+					Hour:     10,
+					ScheduleOptions: types.ScheduleOptions{
+						Blackouts: []types.ScheduleBlackout{
+							{Start: "2025-12-24", End: "2025-12-26"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	nextRun, err := calculateNextRunTime(cronJob)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 12, 27, 10, 0, 0, 0, time.UTC), nextRun.UTC())
+}
+
+func TestLatestTick(t *testing.T) {
+	next := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		schedule  string
+		blackouts []types.ScheduleBlackout
+		now       time.Time
+		want      time.Time
+	}{
+		{
+			name:     "missed ticks",
+			schedule: "0 9 * * *",
+			now:      time.Date(2025, 5, 4, 12, 0, 0, 0, time.UTC),
+			want:     time.Date(2025, 5, 4, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "no missed ticks",
+			schedule: "0 9 * * *",
+			now:      time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+			want:     next,
+		},
+		{
+			name:     "now is on a tick",
+			schedule: "0 9 * * *",
+			now:      time.Date(2025, 5, 3, 9, 0, 42, 0, time.UTC),
+			want:     time.Date(2025, 5, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "years of missed ticks",
+			schedule: "* * * * *",
+			now:      time.Date(2030, 5, 1, 12, 30, 15, 0, time.UTC),
+			want:     time.Date(2030, 5, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "latest tick in a blackout",
+			schedule:  "0 9 * * *",
+			blackouts: []types.ScheduleBlackout{{Start: "2025-05-03", End: "2025-05-04"}},
+			now:       time.Date(2025, 5, 4, 12, 0, 0, 0, time.UTC),
+			want:      time.Date(2025, 5, 2, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "every tick since next in a blackout",
+			schedule:  "0 9 * * *",
+			blackouts: []types.ScheduleBlackout{{Start: "2025-05-02", End: "2025-05-10"}},
+			now:       time.Date(2025, 5, 4, 12, 0, 0, 0, time.UTC),
+			want:      next,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := v1.CronJob{
+				Spec: v1.CronJobSpec{
+					CronJobManifest: types.CronJobManifest{
+						Schedule: tt.schedule,
+						ScheduleOptions: types.ScheduleOptions{
+							Blackouts: tt.blackouts,
+						},
+					},
+				},
+			}
+
+			latest, err := latestTick(cronJob, next, tt.now)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, latest.UTC())
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	cronJob := v1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cj1",
+			Namespace: "default",
+		},
+		Spec: v1.CronJobSpec{
+			CronJobManifest: types.CronJobManifest{
+				Schedule: "0 9 * * *",
+				ScheduleOptions: types.ScheduleOptions{
+					Jitter: "10m",
+				},
+			},
+		},
+	}
+
+	tick := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	delay := jitter(cronJob, tick)
+	require.GreaterOrEqual(t, delay, time.Duration(0))
+	require.Less(t, delay, 10*time.Minute)
+	require.Equal(t, delay, jitter(cronJob, tick))
+
+	cronJob.Spec.Jitter = ""
+	require.Zero(t, jitter(cronJob, tick))
+}
+
+func TestEnded(t *testing.T) {
+	cronJob := v1.CronJob{
+		Spec: v1.CronJobSpec{
+			CronJobManifest: types.CronJobManifest{
+				Schedule: "0 9 * * *",
+				ScheduleOptions: types.ScheduleOptions{
+					EndAfter: "2025-05-01",
+				},
+			},
+		},
+	}
+
+	require.False(t, ended(cronJob, time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)))
+	require.True(t, ended(cronJob, time.Date(2025, 5, 2, 9, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,99 @@
+package emailreceiver
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"github.com/obot-platform/obot/pkg/smtp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var log = logger.Package()
+
+const (
+	// maxReplyAttempts is the number of times a reply is sent before it is given up on.
+	maxReplyAttempts = 5
+	// replyRetryBackoff is how long to wait before the first retry of a reply. The wait doubles for each retry after
+	// that.
+	replyRetryBackoff = 30 * time.Second
+)
+
+type Handler struct {
+	sender   *smtp.Sender
+	hostname string
+}
+
+func New(sender *smtp.Sender, hostname string) *Handler {
+	return &Handler{
+		sender:   sender,
+		hostname: hostname,
+	}
+}
+
+// SendReply sends the output of a completed execution that was started or continued by an email as a reply to it.
+// A reply that fails is retried with backoff, unless the relay rejected it permanently, until the attempts run out.
+func (h *Handler) SendReply(req router.Request, resp router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	email := we.Spec.EmailMessage
+	if h.sender == nil || email == nil || we.Status.State != types.WorkflowStateComplete ||
+		we.Status.WorkflowGeneration != we.Spec.WorkflowGeneration {
+		return nil
+	}
+
+	messageID := emailtrigger.ReplyMessageID(we, h.hostname)
+	if we.Status.EmailReplyMessageID == messageID {
+		return nil
+	}
+
+	failure := we.Status.EmailReplyFailure
+	if failure != nil && failure.MessageID == messageID {
+		if failure.Final {
+			return nil
+		}
+		if wait := time.Until(failure.LastAttempt.Add(replyRetryBackoff << (failure.Attempts - 1))); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	} else {
+		failure = &v1.EmailReplyFailure{MessageID: messageID}
+	}
+
+	subject := email.Subject
+	if !strings.HasPrefix(strings.ToLower(subject), "re:") {
+		subject = "Re: " + subject
+	}
+
+	err := h.sender.Send(smtp.Message{
+		From:       email.From,
+		To:         email.To,
+		Subject:    subject,
+		MessageID:  messageID,
+		InReplyTo:  email.MessageID,
+		References: append(email.References, email.MessageID),
+		Body:       we.Status.Output,
+	})
+	if err == nil {
+		we.Status.EmailReplyMessageID = messageID
+		we.Status.EmailReplyFailure = nil
+		return nil
+	}
+
+	failure.Attempts++
+	failure.LastAttempt = metav1.Now()
+	failure.Error = fmt.Sprintf("failed to send reply to %s: %v", email.To, err)
+	failure.Final = smtp.Permanent(err) || failure.Attempts >= maxReplyAttempts
+	we.Status.EmailReplyFailure = failure
+
+	if failure.Final {
+		log.Errorf("Giving up on the reply of workflow execution %s after %d attempts: %s", we.Name, failure.Attempts, failure.Error)
+	} else {
+		resp.RetryAfter(replyRetryBackoff << (failure.Attempts - 1))
+	}
+	return nil
+}
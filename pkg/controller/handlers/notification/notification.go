@@ -0,0 +1,385 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+	"unicode/utf8"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/smtp"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxAttempts is the number of times a notification is sent before it is marked as failed.
+	maxAttempts = 5
+	// retryBackoff is how long to wait before the first retry. The wait doubles for each retry after that.
+	retryBackoff = 30 * time.Second
+	// deliveryRetention is how long deliveries are kept in the log.
+	deliveryRetention = 7 * 24 * time.Hour
+	// maxTextOutput is how much of the output of a run is included in Slack and email notifications.
+	maxTextOutput = 2000
+)
+
+// sharedAddressSpace is the range of carrier-grade NAT, which some clouds use for their metadata services.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+type Handler struct {
+	sender   *smtp.Sender
+	hostname string
+	client   *http.Client
+}
+
+func New(sender *smtp.Sender, hostname string) *Handler {
+	return &Handler{
+		sender:   sender,
+		hostname: hostname,
+		client:   newClient(),
+	}
+}
+
+// newClient returns a client that only connects to public addresses, so that sinks can't be used to reach the
+// services of the cluster or the metadata service of the cloud. The address is checked when connecting, which also
+// covers redirects and names that resolve to another address after the sink was saved.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: checkAddress,
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// checkAddress rejects connections to loopback, private, link-local (including the metadata service), and other
+// non-public addresses.
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("connections to %s are not allowed", ip)
+	}
+	return nil
+}
+
+// NotifyRun creates the deliveries to the sinks of the task and its project when an execution is done.
+func (h *Handler) NotifyRun(req router.Request, _ router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	if !we.Status.State.IsTerminal() || we.Status.WorkflowGeneration != we.Spec.WorkflowGeneration {
+		return nil
+	}
+
+	event := types.NotificationEventComplete
+	if we.Status.State == types.WorkflowStateError {
+		event = types.NotificationEventError
+	}
+
+	return createDeliveries(req, we, event, fmt.Sprint(we.Spec.WorkflowGeneration), types.NotificationPayload{
+		State:  we.Status.State,
+		Output: we.Status.Output,
+		Error:  we.Status.Error,
+	})
+}
+
+// NotifyApproval creates the deliveries to the sinks of the task and its project when a step starts waiting for
+// approval.
+func (h *Handler) NotifyApproval(req router.Request, _ router.Response) error {
+	step := req.Object.(*v1.WorkflowStep)
+	if step.Spec.Step.Approval == nil || step.Status.State != types.WorkflowStateBlocked || len(step.Status.RunNames) == 0 {
+		return nil
+	}
+
+	var we v1.WorkflowExecution
+	if err := req.Get(&we, step.Namespace, step.Spec.WorkflowExecutionName); apierror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return createDeliveries(req, &we, types.NotificationEventApprovalNeeded, step.Status.RunNames[len(step.Status.RunNames)-1], types.NotificationPayload{
+		State:   types.WorkflowStateBlocked,
+		StepID:  step.Spec.Step.ID,
+		Message: step.Spec.Step.Approval.Message,
+	})
+}
+
+// createDeliveries creates a delivery for each sink that the event is sent to. The key identifies the occurrence of
+// the event, so that each sink is only notified once for it.
+func createDeliveries(req router.Request, we *v1.WorkflowExecution, event types.NotificationEvent, key string, payload types.NotificationPayload) error {
+	var workflow v1.Workflow
+	if err := req.Get(&workflow, we.Namespace, we.Spec.WorkflowName); apierror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	sinks := map[string][]types.NotificationSink{
+		"task": workflow.Spec.Manifest.Notifications,
+	}
+
+	var project v1.Thread
+	if err := req.Get(&project, workflow.Namespace, workflow.Spec.ThreadName); err == nil {
+		sinks["project"] = project.Spec.Manifest.Notifications
+	} else if !apierror.IsNotFound(err) {
+		return err
+	}
+
+	payload.Event = event
+	payload.ProjectID = strings.Replace(workflow.Spec.ThreadName, system.ThreadPrefix, system.ProjectPrefix, 1)
+	payload.TaskID = workflow.Name
+	payload.TaskName = workflow.Spec.Manifest.Name
+	payload.RunID = we.Name
+	payload.Time = *types.NewTime(time.Now())
+
+	for source, sinks := range sinks {
+		for _, sink := range sinks {
+			if len(sink.Events) > 0 && !slices.Contains(sink.Events, event) {
+				continue
+			}
+
+			hash := sha256.Sum256([]byte(strings.Join([]string{we.Name, key, string(event), source, sink.Name}, "\x00")))
+			if err := req.Client.Create(req.Ctx, &v1.NotificationDelivery{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      system.NotificationDeliveryPrefix + hex.EncodeToString(hash[:])[:32],
+					Namespace: we.Namespace,
+				},
+				Spec: v1.NotificationDeliverySpec{
+					ThreadName:            workflow.Spec.ThreadName,
+					WorkflowName:          workflow.Name,
+					WorkflowExecutionName: we.Name,
+					Event:                 event,
+					Sink:                  sink,
+					Payload:               payload,
+				},
+			}); err != nil && !apierror.IsAlreadyExists(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Deliver sends the notification, and retries it with backoff until it is delivered or the attempts run out.
+func (h *Handler) Deliver(req router.Request, resp router.Response) error {
+	delivery := req.Object.(*v1.NotificationDelivery)
+
+	switch delivery.Status.State {
+	case types.NotificationDeliveryStateDelivered, types.NotificationDeliveryStateFailed:
+		return nil
+	case "":
+		delivery.Status.State = types.NotificationDeliveryStatePending
+	}
+
+	if n := len(delivery.Status.Attempts); n > 0 {
+		if wait := time.Until(delivery.Status.Attempts[n-1].Time.Add(retryBackoff << (n - 1))); wait > 0 {
+			resp.RetryAfter(wait)
+			return nil
+		}
+	}
+
+	attempt := v1.NotificationAttempt{
+		Time: metav1.Now(),
+	}
+	statusCode, err := h.send(req.Ctx, delivery)
+	attempt.StatusCode = statusCode
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+	delivery.Status.Attempts = append(delivery.Status.Attempts, attempt)
+
+	switch {
+	case err == nil:
+		delivery.Status.State = types.NotificationDeliveryStateDelivered
+	case len(delivery.Status.Attempts) >= maxAttempts:
+		delivery.Status.State = types.NotificationDeliveryStateFailed
+	default:
+		resp.RetryAfter(retryBackoff << (len(delivery.Status.Attempts) - 1))
+	}
+
+	return nil
+}
+
+// DeleteExpired deletes deliveries once they are older than the retention period.
+func (h *Handler) DeleteExpired(req router.Request, resp router.Response) error {
+	delivery := req.Object.(*v1.NotificationDelivery)
+
+	expires := delivery.CreationTimestamp.Add(deliveryRetention)
+	if time.Now().After(expires) {
+		return req.Delete(delivery)
+	}
+
+	if until := time.Until(expires); until < 10*time.Hour {
+		resp.RetryAfter(until)
+	}
+
+	return nil
+}
+
+func (h *Handler) send(ctx context.Context, delivery *v1.NotificationDelivery) (int, error) {
+	sink := delivery.Spec.Sink
+	payload := delivery.Spec.Payload
+
+	switch {
+	case sink.Slack != nil:
+		body, err := json.Marshal(map[string]string{
+			"text": text(payload),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return h.post(ctx, sink.Slack.WebhookURL, body, nil)
+	case sink.HTTP != nil:
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return 0, err
+		}
+		header := http.Header{
+			"X-Obot-Event":    {string(payload.Event)},
+			"X-Obot-Delivery": {delivery.Name},
+		}
+		if sink.HTTP.Secret != "" {
+			mac := hmac.New(sha256.New, []byte(sink.HTTP.Secret))
+			mac.Write(body)
+			header.Set("X-Obot-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+		}
+		return h.post(ctx, sink.HTTP.URL, body, header)
+	case sink.Email != nil:
+		if h.sender == nil {
+			return 0, fmt.Errorf("email notifications require an SMTP relay")
+		}
+		// The recipients that were sent the notification in an earlier attempt are skipped, so that a retry after
+		// a failure for one recipient does not send it to the others again.
+		for _, to := range sink.Email.To {
+			if slices.Contains(delivery.Status.SentTo, to) {
+				continue
+			}
+			if err := h.sender.Send(smtp.Message{
+				From:    "notifications@" + h.hostname,
+				To:      to,
+				Subject: subject(payload),
+				Body:    text(payload),
+			}); err != nil {
+				return 0, fmt.Errorf("failed to send email to %s: %w", to, err)
+			}
+			delivery.Status.SentTo = append(delivery.Status.SentTo, to)
+		}
+		return 0, nil
+	}
+
+	return 0, fmt.Errorf("sink %q has no destination", sink.Name)
+}
+
+func (h *Handler) post(ctx context.Context, target string, body []byte, header http.Header) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return 0, withoutURL(err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return 0, withoutURL(err)
+	}
+	defer resp.Body.Close()
+
+	// The body of the response is not recorded, because it is up to the receiver and can include anything.
+	if resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// withoutURL removes the URL from the error of a request, because the URL is a secret for Slack webhooks.
+func withoutURL(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+	return err
+}
+
+func subject(payload types.NotificationPayload) string {
+	name := payload.TaskName
+	if name == "" {
+		name = payload.TaskID
+	}
+
+	switch payload.Event {
+	case types.NotificationEventComplete:
+		return fmt.Sprintf("Task %q completed", name)
+	case types.NotificationEventError:
+		return fmt.Sprintf("Task %q failed", name)
+	case types.NotificationEventApprovalNeeded:
+		return fmt.Sprintf("Task %q is waiting for approval", name)
+	}
+	return fmt.Sprintf("Task %q: %s", name, payload.Event)
+}
+
+func text(payload types.NotificationPayload) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (run %s in project %s)", subject(payload), payload.RunID, payload.ProjectID)
+
+	switch payload.Event {
+	case types.NotificationEventComplete:
+		if payload.Output != "" {
+			b.WriteString("\n\n" + truncate(payload.Output))
+		}
+	case types.NotificationEventError:
+		if payload.Error != "" {
+			b.WriteString("\n\nError: " + truncate(payload.Error))
+		}
+	case types.NotificationEventApprovalNeeded:
+		fmt.Fprintf(&b, "\n\nStep %s", payload.StepID)
+		if payload.Message != "" {
+			b.WriteString(": " + payload.Message)
+		}
+	}
+
+	return b.String()
+}
+
+// truncate cuts s to at most maxTextOutput bytes, at the start of a rune so that the text stays valid UTF-8.
+func truncate(s string) string {
+	if len(s) <= maxTextOutput {
+		return s
+	}
+	end := maxTextOutput
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + "..."
+}
@@ -0,0 +1,64 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		allowed bool
+	}{
+		{address: "93.184.215.14:443", allowed: true},
+		{address: "[2606:2800:21f:cb07:6820:80da:af6b:8b2c]:443", allowed: true},
+		{address: "127.0.0.1:80"},
+		{address: "[::1]:80"},
+		{address: "10.0.0.1:80"},
+		{address: "172.16.0.1:80"},
+		{address: "192.168.1.1:80"},
+		{address: "169.254.169.254:80"},
+		{address: "100.100.100.200:80"},
+		{address: "0.0.0.0:80"},
+		{address: "[fd00::1]:80"},
+		{address: "[fe80::1]:80"},
+		{address: "[::ffff:127.0.0.1]:80"},
+		{address: "[::ffff:169.254.169.254]:80"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := checkAddress("tcp", tt.address, nil)
+			if tt.allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{name: "short", s: "hello", want: "hello"},
+		{name: "exactly the limit", s: strings.Repeat("a", maxTextOutput), want: strings.Repeat("a", maxTextOutput)},
+		{name: "ascii", s: strings.Repeat("a", maxTextOutput+1), want: strings.Repeat("a", maxTextOutput) + "..."},
+		{name: "rune across the limit", s: strings.Repeat("a", maxTextOutput-1) + "é", want: strings.Repeat("a", maxTextOutput-1) + "..."},
+		{name: "multi-byte runes", s: strings.Repeat("日", maxTextOutput), want: strings.Repeat("日", maxTextOutput/3) + "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.s)
+			require.True(t, utf8.ValidString(got))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
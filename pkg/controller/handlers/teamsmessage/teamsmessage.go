@@ -0,0 +1,197 @@
+package teamsmessage
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"github.com/obot-platform/obot/pkg/teams"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = logger.Package()
+
+// messageRetention is how long a message is kept, so that the Bot Framework sending the activity again does not run
+// it again.
+const messageRetention = 24 * time.Hour
+
+const errorReply = "Sorry, something went wrong while responding to this message."
+
+type Handler struct {
+	invoker   *invoke.Invoker
+	gptClient *gptscript.GPTScript
+
+	lock sync.Mutex
+	// clients are the clients of the receivers, which are kept so that their access tokens are reused.
+	clients map[string]*teams.Client
+}
+
+func New(invoker *invoke.Invoker, gptClient *gptscript.GPTScript) *Handler {
+	return &Handler{
+		invoker:   invoker,
+		gptClient: gptClient,
+		clients:   map[string]*teams.Client{},
+	}
+}
+
+// Reply runs the thread of the conversation with the message, and sends the output as a reply to the message once the
+// run is done.
+func (h *Handler) Reply(req router.Request, _ router.Response) error {
+	msg := req.Object.(*v1.TeamsMessage)
+	if msg.Status.Replied {
+		return nil
+	}
+
+	if msg.Status.RunName == "" {
+		return h.run(req, msg)
+	}
+
+	var (
+		run    v1.Run
+		output string
+	)
+	if err := req.Get(&run, msg.Namespace, msg.Status.RunName); apierrors.IsNotFound(err) {
+		log.Errorf("Run %s for Teams message %s not found", msg.Status.RunName, msg.Name)
+		output = errorReply
+	} else if err != nil {
+		return err
+	} else if !done(run) {
+		// This is called again when the run changes.
+		return nil
+	} else if run.Status.State == v1.Error {
+		log.Errorf("Run %s for Teams message %s failed: %s", run.Name, msg.Name, run.Status.Error)
+		output = errorReply
+	} else {
+		output = run.Status.Output
+	}
+
+	var activity teams.Activity
+	if err := json.Unmarshal(msg.Spec.Activity, &activity); err != nil {
+		return fmt.Errorf("invalid activity of Teams message %s: %w", msg.Name, err)
+	}
+
+	client, err := h.client(req, msg.Spec.ReceiverName)
+	if err != nil {
+		return err
+	}
+
+	if err := client.Reply(req.Ctx, activity, output); err != nil {
+		return fmt.Errorf("failed to reply to Teams message %s: %w", msg.Name, err)
+	}
+
+	msg.Status.Replied = true
+	return nil
+}
+
+// run starts a run of the thread with the message once the messages before it are answered, so that the messages of a
+// conversation are answered in turn.
+func (h *Handler) run(req router.Request, msg *v1.TeamsMessage) error {
+	var msgs v1.TeamsMessageList
+	if err := req.List(&msgs, &kclient.ListOptions{
+		Namespace:     msg.Namespace,
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.threadName": msg.Spec.ThreadName}),
+	}); err != nil {
+		return err
+	}
+
+	for _, other := range msgs.Items {
+		if other.Name == msg.Name || other.Status.Replied {
+			continue
+		}
+		if other.Status.RunName != "" || before(other, *msg) {
+			// This is called again when the other message changes.
+			return nil
+		}
+	}
+
+	var thread v1.Thread
+	if err := req.Get(&thread, msg.Namespace, msg.Spec.ThreadName); err != nil {
+		return err
+	}
+
+	if thread.Status.CurrentRunName != "" {
+		var current v1.Run
+		if err := req.Get(&current, msg.Namespace, thread.Status.CurrentRunName); err == nil && !done(current) {
+			// This is called again when the run or thread changes.
+			return nil
+		} else if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	resp, err := h.invoker.Thread(req.Ctx, req.Client, &thread, msg.Spec.Input, invoke.Options{
+		GenerateName: system.ChatRunPrefix,
+		UserUID:      thread.Spec.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Close()
+
+	msg.Status.RunName = resp.Run.Name
+	return nil
+}
+
+// client returns the client for the app of the receiver, which is replaced if the credentials of the app changed.
+func (h *Handler) client(req router.Request, receiverName string) (*teams.Client, error) {
+	var receiver v1.TeamsReceiver
+	if err := req.Get(&receiver, req.Namespace, receiverName); err != nil {
+		return nil, err
+	}
+
+	cred, err := h.gptClient.RevealCredential(req.Ctx, []string{receiver.Name}, teams.CredentialToolName)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		appID    = receiver.Spec.Manifest.AppID
+		password = cred.Env["APP_PASSWORD"]
+		tenantID = receiver.Spec.Manifest.TenantID
+	)
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	client, ok := h.clients[receiver.Name]
+	if !ok || !client.Matches(appID, password, tenantID) {
+		client = teams.NewClient(appID, password, tenantID)
+		h.clients[receiver.Name] = client
+	}
+	return client, nil
+}
+
+// DeleteExpired deletes messages once the Bot Framework will no longer send them again.
+func (h *Handler) DeleteExpired(req router.Request, resp router.Response) error {
+	msg := req.Object.(*v1.TeamsMessage)
+
+	expires := msg.Spec.ReceivedAt.Add(messageRetention)
+	if time.Now().After(expires) {
+		return req.Delete(msg)
+	}
+
+	resp.RetryAfter(time.Until(expires))
+	return nil
+}
+
+// before returns whether a message was received before another, using the names to order messages received at the
+// same time.
+func before(a, b v1.TeamsMessage) bool {
+	if !a.Spec.ReceivedAt.Equal(&b.Spec.ReceivedAt) {
+		return a.Spec.ReceivedAt.Before(&b.Spec.ReceivedAt)
+	}
+	return a.Name < b.Name
+}
+
+func done(run v1.Run) bool {
+	return run.Status.State == v1.Continue || run.Status.State == v1.Finished || run.Status.State == v1.Error
+}
@@ -46,6 +46,36 @@ func (t *Handler) WorkflowState(req router.Request, _ router.Response) error {
 	return nil
 }
 
+// AbortWithWorkflowExecution aborts the threads that the lanes of a parallel step run on when the thread of their
+// workflow execution is aborted.
+func (t *Handler) AbortWithWorkflowExecution(req router.Request, _ router.Response) error {
+	thread := req.Object.(*v1.Thread)
+	if !thread.Spec.SystemTask || thread.Spec.WorkflowExecutionName == "" || thread.Spec.Abort {
+		return nil
+	}
+
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, thread.Namespace, thread.Spec.WorkflowExecutionName); err != nil {
+		return kclient.IgnoreNotFound(err)
+	}
+
+	if wfe.Status.ThreadName == "" || wfe.Status.ThreadName == thread.Name {
+		return nil
+	}
+
+	var wfeThread v1.Thread
+	if err := req.Get(&wfeThread, thread.Namespace, wfe.Status.ThreadName); err != nil {
+		return kclient.IgnoreNotFound(err)
+	}
+
+	if !wfeThread.Spec.Abort {
+		return nil
+	}
+
+	thread.Spec.Abort = true
+	return req.Client.Update(req.Ctx, thread)
+}
+
 func getParentWorkspaceNames(ctx context.Context, c kclient.Client, thread *v1.Thread) ([]string, bool, error) {
 	var result []string
 
@@ -1,6 +1,8 @@
 package webhook
 
 import (
+	"time"
+
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
@@ -33,3 +35,21 @@ func (h *Handler) SetSuccessRunTime(req router.Request, _ router.Response) error
 
 	return nil
 }
+
+// deliveryRetention is how long deliveries are kept, so that they can be redelivered.
+const deliveryRetention = 7 * 24 * time.Hour
+
+func (h *Handler) DeleteExpiredDeliveries(req router.Request, resp router.Response) error {
+	delivery := req.Object.(*v1.WebhookDelivery)
+
+	expires := delivery.Spec.ReceivedAt.Add(deliveryRetention)
+	if time.Now().After(expires) {
+		return req.Delete(delivery)
+	}
+
+	if until := time.Until(expires); until < 10*time.Hour {
+		resp.RetryAfter(until)
+	}
+
+	return nil
+}
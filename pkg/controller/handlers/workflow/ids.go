@@ -7,10 +7,7 @@ import (
 
 func PopulateIDs(manifest types.WorkflowManifest) types.WorkflowManifest {
 	manifest = *manifest.DeepCopy()
-	ids := map[string]struct{}{}
-	for i, step := range manifest.Steps {
-		manifest.Steps[i] = populateStepID(ids, step)
-	}
+	manifest.Steps = populateStepIDs(map[string]struct{}{}, manifest.Steps)
 	return manifest
 }
 
@@ -33,6 +30,26 @@ func populateStepID(seen map[string]struct{}, step types.Step) types.Step {
 		step.ID = nextID(seen)
 	} else if _, ok := seen[step.ID]; ok {
 		step.ID = nextID(seen)
+	} else {
+		seen[step.ID] = struct{}{}
+	}
+	if step.If != nil {
+		step.If.Steps = populateStepIDs(seen, step.If.Steps)
+		step.If.Else = populateStepIDs(seen, step.If.Else)
+	}
+	if step.Switch != nil {
+		for i := range step.Switch.Cases {
+			step.Switch.Cases[i].Steps = populateStepIDs(seen, step.Switch.Cases[i].Steps)
+		}
+		step.Switch.Default = populateStepIDs(seen, step.Switch.Default)
 	}
+	step.Parallel = populateStepIDs(seen, step.Parallel)
 	return step
 }
+
+func populateStepIDs(seen map[string]struct{}, steps []types.Step) []types.Step {
+	for i, step := range steps {
+		steps[i] = populateStepID(seen, step)
+	}
+	return steps
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/obot-platform/nah/pkg/router"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func EnsureIDs(req router.Request, _ router.Response) error {
@@ -15,3 +16,19 @@ func EnsureIDs(req router.Request, _ router.Response) error {
 	}
 	return nil
 }
+
+// SetOnTaskCompletionSince records when the task completion trigger of the workflow was set, so that runs of the
+// other task that were done before then do not trigger the workflow.
+func SetOnTaskCompletionSince(req router.Request, _ router.Response) error {
+	wf := req.Object.(*v1.Workflow)
+	trigger := wf.Spec.Manifest.OnTaskCompletion
+	if trigger == nil {
+		wf.Status.OnTaskCompletionTaskID = ""
+		wf.Status.OnTaskCompletionSince = nil
+	} else if wf.Status.OnTaskCompletionSince == nil || wf.Status.OnTaskCompletionTaskID != trigger.TaskID {
+		wf.Status.OnTaskCompletionTaskID = trigger.TaskID
+		now := metav1.Now()
+		wf.Status.OnTaskCompletionSince = &now
+	}
+	return nil
+}
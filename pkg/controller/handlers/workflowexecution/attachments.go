@@ -0,0 +1,83 @@
+package workflowexecution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/logger"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+var log = logger.Package()
+
+// copyAttachments copies the files of the attachments workspace of the execution into the workspace of its thread.
+// It returns false if the workspace of the thread has not been created yet.
+func (h *Handler) copyAttachments(ctx context.Context, we *v1.WorkflowExecution, thread *v1.Thread) (bool, error) {
+	if we.Spec.AttachmentsWorkspaceID == "" || we.Status.CopiedAttachmentsWorkspaceID == we.Spec.AttachmentsWorkspaceID {
+		return true, nil
+	}
+	if thread.Status.WorkspaceID == "" {
+		return false, nil
+	}
+
+	files, err := h.gptClient.ListFilesInWorkspace(ctx, gptscript.ListFilesInWorkspaceOptions{
+		WorkspaceID: we.Spec.AttachmentsWorkspaceID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	for _, file := range files {
+		data, err := h.gptClient.ReadFileInWorkspace(ctx, file, gptscript.ReadFileInWorkspaceOptions{
+			WorkspaceID: we.Spec.AttachmentsWorkspaceID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to read attachment %q: %w", file, err)
+		}
+		if err = h.gptClient.WriteFileInWorkspace(ctx, file, data, gptscript.WriteFileInWorkspaceOptions{
+			WorkspaceID: thread.Status.WorkspaceID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to copy attachment %q: %w", file, err)
+		}
+	}
+
+	// The workspace is deleted by CleanupAttachments once this is saved, so the files can be copied again if it is not.
+	we.Status.CopiedAttachmentsWorkspaceID = we.Spec.AttachmentsWorkspaceID
+	return true, nil
+}
+
+// CleanupAttachments deletes the attachments workspace of an execution once its files were copied to the thread, or
+// once the execution ended without starting, such as when it was skipped by the concurrency policy of its task.
+func (h *Handler) CleanupAttachments(req router.Request, _ router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+
+	// This handler runs before Run, so the copied workspace was saved by an earlier reconcile.
+	h.deleteAttachments(req.Ctx, we, we.Status.CopiedAttachmentsWorkspaceID)
+
+	if we.Spec.AttachmentsWorkspaceID != "" && we.Status.ThreadName == "" && we.Status.State.IsTerminal() {
+		h.deleteAttachments(req.Ctx, we, we.Spec.AttachmentsWorkspaceID)
+	}
+	return nil
+}
+
+// RemoveAttachments deletes the attachments workspaces of an execution that is being deleted.
+func (h *Handler) RemoveAttachments(req router.Request, _ router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	h.deleteAttachments(req.Ctx, we, we.Status.CopiedAttachmentsWorkspaceID)
+	h.deleteAttachments(req.Ctx, we, we.Spec.AttachmentsWorkspaceID)
+	return nil
+}
+
+// deleteAttachments deletes the attachments workspace and records that it was deleted, unless it already was.
+func (h *Handler) deleteAttachments(ctx context.Context, we *v1.WorkflowExecution, workspaceID string) {
+	if workspaceID == "" || we.Status.DeletedAttachmentsWorkspaceID == workspaceID {
+		return
+	}
+
+	if err := h.gptClient.DeleteWorkspace(ctx, workspaceID); err != nil {
+		log.Warnf("failed to delete attachments workspace %s of workflow execution %s: %v", workspaceID, we.Name, err)
+	}
+	we.Status.DeletedAttachmentsWorkspaceID = workspaceID
+}
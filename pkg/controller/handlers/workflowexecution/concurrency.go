@@ -0,0 +1,125 @@
+package workflowexecution
+
+import (
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// checkConcurrency applies the concurrency policy of the workflow to an execution that has not started yet and
+// returns whether it can start.
+func checkConcurrency(req router.Request, we *v1.WorkflowExecution) (bool, error) {
+	concurrency := we.Status.WorkflowManifest.Concurrency
+	if concurrency == nil || concurrency.Policy == "" || concurrency.Policy == types.ConcurrencyPolicyAllow ||
+		we.Status.ThreadName != "" || !triggered(we) {
+		return true, nil
+	}
+
+	var wfes v1.WorkflowExecutionList
+	if err := req.List(&wfes, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.workflowName": we.Spec.WorkflowName}),
+		Namespace:     we.Namespace,
+	}); err != nil {
+		return false, err
+	}
+
+	var (
+		// active are the other executions that are running or that were triggered before this one.
+		active []v1.WorkflowExecution
+		queued int
+		newer  bool
+	)
+	for _, other := range wfes.Items {
+		if other.Name == we.Name || other.Status.State.IsTerminal() {
+			continue
+		}
+		started := other.Status.ThreadName != ""
+		if !started && !triggered(&other) {
+			continue
+		}
+		if createdBefore(&other, we) {
+			active = append(active, other)
+			if !started {
+				queued++
+			}
+		} else if started {
+			active = append(active, other)
+		} else {
+			newer = true
+		}
+	}
+
+	switch concurrency.Policy {
+	case types.ConcurrencyPolicyForbid:
+		if len(active) > 0 {
+			skip(we, "Skipped because another run of the task is running")
+			return false, nil
+		}
+	case types.ConcurrencyPolicyReplace:
+		if newer {
+			skip(we, "Replaced by a newer run of the task")
+			return false, nil
+		}
+		for _, other := range active {
+			if err := replace(req, &other); err != nil {
+				return false, err
+			}
+		}
+	case types.ConcurrencyPolicyQueue:
+		if concurrency.MaxQueueDepth > 0 && queued >= concurrency.MaxQueueDepth {
+			skip(we, "Skipped because the queue of runs of the task is full")
+			return false, nil
+		}
+		if len(active) > 0 {
+			// The change of the active executions will trigger this one again.
+			we.Status.State = types.WorkflowStatePending
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// triggered returns whether the execution was started by a schedule, webhook, email, or the completion of another task.
+func triggered(we *v1.WorkflowExecution) bool {
+	return we.Spec.CronJobName != "" || we.Spec.WebhookName != "" || we.Spec.EmailReceiverName != "" || we.Spec.TriggeredBy != ""
+}
+
+func createdBefore(a, b *v1.WorkflowExecution) bool {
+	if a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.Name < b.Name
+	}
+	return a.CreationTimestamp.Before(&b.CreationTimestamp)
+}
+
+func skip(we *v1.WorkflowExecution, reason string) {
+	we.Status.State = types.WorkflowStateError
+	we.Status.Error = reason
+	we.Status.EndTime = &metav1.Time{Time: time.Now()}
+}
+
+// replace aborts an execution that is running or marks an execution that has not started as skipped.
+func replace(req router.Request, we *v1.WorkflowExecution) error {
+	if we.Status.ThreadName == "" {
+		skip(we, "Replaced by a newer run of the task")
+		return req.Client.Status().Update(req.Ctx, we)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var thread v1.Thread
+		if err := req.Client.Get(req.Ctx, router.Key(we.Namespace, we.Status.ThreadName), &thread); err != nil {
+			return kclient.IgnoreNotFound(err)
+		}
+		if thread.Spec.Abort {
+			return nil
+		}
+		thread.Spec.Abort = true
+		return req.Client.Update(req.Ctx, &thread)
+	})
+}
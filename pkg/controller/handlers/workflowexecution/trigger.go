@@ -0,0 +1,99 @@
+package workflowexecution
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TriggerOnCompletion starts the workflows that are triggered by the completion of the execution.
+func (h *Handler) TriggerOnCompletion(req router.Request, _ router.Response) error {
+	we := req.Object.(*v1.WorkflowExecution)
+	if !we.Status.State.IsTerminal() || we.Status.WorkflowGeneration != we.Spec.WorkflowGeneration {
+		return nil
+	}
+
+	var source v1.Workflow
+	if err := req.Get(&source, we.Namespace, we.Spec.WorkflowName); apierror.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var workflows v1.WorkflowList
+	if err := req.List(&workflows, &kclient.ListOptions{
+		FieldSelector: fields.SelectorFromSet(map[string]string{"spec.onTaskCompletion": we.Spec.WorkflowName}),
+		Namespace:     we.Namespace,
+	}); err != nil {
+		return err
+	}
+
+	breadCrumb := append(strings.Split(we.Spec.TaskBreakCrumb, ","), we.Spec.WorkflowName)
+	for _, workflow := range workflows.Items {
+		trigger := workflow.Spec.Manifest.OnTaskCompletion
+		if trigger == nil || workflow.Spec.ThreadName != source.Spec.ThreadName ||
+			len(trigger.States) > 0 && !slices.Contains(trigger.States, we.Status.State) ||
+			slices.Contains(breadCrumb, workflow.Name) {
+			continue
+		}
+
+		if since := workflow.Status.OnTaskCompletionSince; since == nil || workflow.Status.OnTaskCompletionTaskID != trigger.TaskID ||
+			we.Status.EndTime == nil || we.Status.EndTime.Before(since) {
+			// The run was done before the trigger was set.
+			continue
+		}
+
+		input, err := completionInput(we, trigger)
+		if err != nil {
+			return err
+		}
+
+		// The name is the same each time this execution is processed, so the workflow is only triggered once.
+		if err := req.Client.Create(req.Ctx, &v1.WorkflowExecution{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      system.WorkflowExecutionPrefix + name.SafeHashConcatName(we.Name, fmt.Sprint(we.Spec.WorkflowGeneration), workflow.Name),
+				Namespace: we.Namespace,
+			},
+			Spec: v1.WorkflowExecutionSpec{
+				WorkflowName:   workflow.Name,
+				Input:          input,
+				ThreadName:     workflow.Spec.ThreadName,
+				TriggeredBy:    we.Name,
+				TaskBreakCrumb: strings.Trim(strings.Join(breadCrumb, ","), ","),
+			},
+		}); err != nil && !apierror.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func completionInput(we *v1.WorkflowExecution, trigger *types.TaskOnTaskCompletion) (string, error) {
+	input := map[string]string{
+		"taskID": we.Spec.WorkflowName,
+		"runID":  we.Name,
+		"state":  string(we.Status.State),
+	}
+	if trigger.PassOutput {
+		input["output"] = we.Status.Output
+		input["error"] = we.Status.Error
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal input: %w", err)
+	}
+	return string(data), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/apply"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -17,12 +18,14 @@ import (
 )
 
 type Handler struct {
-	invoker *invoke.Invoker
+	invoker   *invoke.Invoker
+	gptClient *gptscript.GPTScript
 }
 
-func New(invoker *invoke.Invoker) *Handler {
+func New(invoker *invoke.Invoker, gptClient *gptscript.GPTScript) *Handler {
 	return &Handler{
-		invoker: invoker,
+		invoker:   invoker,
+		gptClient: gptClient,
 	}
 }
 
@@ -83,6 +86,10 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		return err
 	}
 
+	if start, err := checkConcurrency(req, we); err != nil || !start {
+		return err
+	}
+
 	if we.Status.ThreadName == "" {
 		t, err := h.newThread(req.Ctx, req.Client, &wf, we)
 		if err != nil {
@@ -93,6 +100,11 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 		if err = req.Client.Status().Update(req.Ctx, we); err != nil {
 			return err
 		}
+
+		if we.Spec.AttachmentsWorkspaceID != "" {
+			// Wait for the workspace of the thread to be created so the attachments can be copied into it.
+			return nil
+		}
 	} else {
 		var thread v1.Thread
 		if err := req.Get(&thread, we.Namespace, we.Status.ThreadName); err != nil {
@@ -124,6 +136,10 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 				return err
 			}
 		}
+
+		if copied, err := h.copyAttachments(req.Ctx, we, &thread); err != nil || !copied {
+			return err
+		}
 	}
 
 	var (
@@ -173,6 +189,11 @@ func (h *Handler) Run(req router.Request, _ router.Response) error {
 }
 
 func (h *Handler) loadManifest(req router.Request, we *v1.WorkflowExecution) error {
+	if we.Spec.Manifest != nil {
+		we.Status.WorkflowManifest = we.Spec.Manifest
+		return nil
+	}
+
 	var wf v1.Workflow
 	if err := req.Get(&wf, we.Namespace, we.Spec.WorkflowName); err != nil {
 		return err
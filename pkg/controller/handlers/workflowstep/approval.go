@@ -0,0 +1,184 @@
+package workflowstep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// approvalCallType is the type of the external call that the run of an approval step waits for.
+const approvalCallType = "obotApproval"
+
+// RunApproval runs an approval step. The step starts a run that waits for the decision as an external call, so the
+// task is blocked until the decision is added to the run as the result of the call. The run does not use the model;
+// it passes the conversation through to the steps after it.
+func (h *Handler) RunApproval(req router.Request, resp router.Response) error {
+	step := req.Object.(*v1.WorkflowStep)
+
+	approval := step.Spec.Step.Approval
+	if approval == nil {
+		return nil
+	}
+
+	// reset
+	step.Status.Error = ""
+
+	var timeout time.Duration
+	if approval.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(approval.Timeout); err != nil {
+			step.Status.State = types.WorkflowStateError
+			step.Status.Error = fmt.Sprintf("invalid approval timeout %q: %v", approval.Timeout, err)
+			return nil
+		}
+	}
+
+	var run v1.Run
+	if len(step.Status.RunNames) == 0 {
+		lastRunName, err := previousLastRunName(req, step)
+		if err != nil {
+			return err
+		}
+
+		call, err := approvalCall(step)
+		if err != nil {
+			return err
+		}
+
+		invokeResp, err := h.invoker.StepWait(req.Ctx, req.Client, step, invoke.StepOptions{
+			PreviousRunName: lastRunName,
+		}, call)
+		if err != nil {
+			return err
+		}
+
+		newRun, err := h.recordRun(req, step, invokeResp, false)
+		if err != nil {
+			return err
+		}
+		run = *newRun
+	} else if err := req.Get(&run, step.Namespace, step.Status.RunNames[len(step.Status.RunNames)-1]); err != nil {
+		return err
+	}
+
+	switch run.Status.State {
+	case v1.Continue:
+		step.Status.LastRunName = run.Name
+		decision, err := ApprovalDecision(&run)
+		if err == nil && decision == nil {
+			err = fmt.Errorf("run %s completed without a decision", run.Name)
+		}
+		if err != nil {
+			step.Status.State = types.WorkflowStateError
+			step.Status.Error = err.Error()
+			return nil
+		}
+		if decision.Decision == types.ApprovalDecisionRejected {
+			step.Status.State = types.WorkflowStateError
+			step.Status.Error = "Rejected"
+			if decision.DecidedBy != "" {
+				step.Status.Error += " by " + decision.DecidedBy
+			}
+			if decision.Comment != "" {
+				step.Status.Error += ": " + decision.Comment
+			}
+			return nil
+		}
+		step.Status.State = types.WorkflowStateComplete
+		return nil
+	case v1.Error, v1.Finished:
+		h.setStepStateFromRun(step, &run)
+		return nil
+	}
+
+	if timeout > 0 && run.Status.State == v1.Waiting {
+		if wait := time.Until(run.CreationTimestamp.Add(timeout)); wait > 0 {
+			resp.RetryAfter(wait)
+		} else {
+			decision := types.ApprovalDecisionRejected
+			if approval.DefaultAction == "approve" {
+				decision = types.ApprovalDecisionApproved
+			}
+			if err := Decide(req.Ctx, req.Client, &run, types.StepApprovalStatus{
+				Decision: decision,
+				Comment:  "No decision was made before the timeout",
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	step.Status.State = types.WorkflowStateBlocked
+	step.Status.Error = "Waiting for approval"
+	if approval.Message != "" {
+		step.Status.Error += ": " + approval.Message
+	}
+	return nil
+}
+
+// approvalCall returns the external call that the run of an approval step waits for.
+func approvalCall(step *v1.WorkflowStep) (v1.ExternalCall, error) {
+	data, err := json.Marshal(types.StepApprovalStatus{
+		StepID:  step.Spec.Step.ID,
+		Message: step.Spec.Step.Approval.Message,
+	})
+	if err != nil {
+		return v1.ExternalCall{}, err
+	}
+
+	return v1.ExternalCall{
+		ID:   step.Name,
+		Type: approvalCallType,
+		Data: string(data),
+	}, nil
+}
+
+// Decide records the decision for the run of an approval step as the result of the call that the run waits for.
+func Decide(ctx context.Context, c kclient.Client, run *v1.Run, decision types.StepApprovalStatus) error {
+	if run.Spec.WaitFor == nil || run.Spec.WaitFor.Type != approvalCallType {
+		return fmt.Errorf("run %s is not waiting for approval", run.Name)
+	}
+
+	if decision.DecidedAt == nil {
+		decision.DecidedAt = types.NewTime(time.Now())
+	}
+
+	data, err := json.Marshal(decision)
+	if err != nil {
+		return err
+	}
+
+	run.Spec.ExternalCallResults = append(run.Spec.ExternalCallResults, v1.ExternalCallResult{
+		ID:   run.Spec.WaitFor.ID,
+		Data: string(data),
+	})
+	return c.Update(ctx, run)
+}
+
+// ApprovalDecision returns the decision for the run of an approval step, or nil if no decision has been made.
+func ApprovalDecision(run *v1.Run) (*types.StepApprovalStatus, error) {
+	if run.Spec.WaitFor == nil {
+		return nil, nil
+	}
+
+	for _, result := range run.Spec.ExternalCallResults {
+		if result.ID != run.Spec.WaitFor.ID {
+			continue
+		}
+
+		var decision types.StepApprovalStatus
+		if err := json.Unmarshal([]byte(result.Data), &decision); err != nil {
+			return nil, fmt.Errorf("invalid approval decision: %w", err)
+		}
+		return &decision, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,206 @@
+package workflowstep
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/expression"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	branchThen    = "then"
+	branchElse    = "else"
+	branchDefault = "default"
+)
+
+func (h *Handler) RunBranch(req router.Request, _ router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	if rootStep.Spec.Step.If == nil && rootStep.Spec.Step.Switch == nil {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+
+	var (
+		value         string
+		fromModel     bool
+		afterStepName = rootStep.Spec.AfterWorkflowStepName
+	)
+	if expr := branchExpression(rootStep.Spec.Step); expr != "" {
+		value, err = expression.Evaluate(expr, func(ref string) (string, error) {
+			return resolveReference(req.Ctx, req.Client, rootStep, ref)
+		})
+		if err != nil {
+			rootStep.Status.State = types.WorkflowStateError
+			rootStep.Status.Error = err.Error()
+			return nil
+		}
+	} else {
+		conditionStep := defineConditionStep(rootStep)
+		objects = append(objects, conditionStep)
+
+		_, output, state, err := GetStateFromSteps(req.Ctx, req.Client, rootStep.Spec.WorkflowGeneration, conditionStep)
+		if err != nil {
+			return err
+		} else if state.IsBlocked() {
+			rootStep.Status.State = state
+			rootStep.Status.Error = output
+			return nil
+		} else if state != types.WorkflowStateComplete {
+			rootStep.Status.State = types.WorkflowStateRunning
+			return nil
+		}
+
+		value = output
+		fromModel = true
+		afterStepName = conditionStep.Name
+	}
+
+	branchName, branch, err := selectBranch(rootStep.Spec.Step, value, fromModel)
+	if err != nil {
+		rootStep.Status.State = types.WorkflowStateError
+		rootStep.Status.Error = err.Error()
+		return nil
+	}
+	rootStep.Status.Branch = branchName
+
+	lastStepName := afterStepName
+	for _, step := range branch {
+		newStep := newChildStep(rootStep, lastStepName, step)
+		objects = append(objects, newStep)
+		lastStepName = newStep.Name
+	}
+
+	if len(objects) == 0 {
+		// The expression selected an empty branch, so there is nothing to run. Pass along the run of the previous
+		// step, if there is one.
+		lastRunName, err := previousLastRunName(req, rootStep)
+		if err != nil {
+			return err
+		}
+
+		completeResponse = true
+		rootStep.Status.State = types.WorkflowStateComplete
+		rootStep.Status.LastRunName = lastRunName
+		return nil
+	}
+
+	runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, rootStep.Spec.WorkflowGeneration, objects...)
+	if err != nil {
+		return err
+	}
+
+	if newState.IsBlocked() {
+		rootStep.Status.State = newState
+		rootStep.Status.Error = errMsg
+		return nil
+	}
+
+	if newState != types.WorkflowStateComplete {
+		rootStep.Status.State = newState
+		return nil
+	}
+
+	completeResponse = true
+	rootStep.Status.State = types.WorkflowStateComplete
+	rootStep.Status.LastRunName = runName
+	return nil
+}
+
+func branchExpression(step types.Step) string {
+	if step.If != nil {
+		return step.If.Expression
+	}
+	return step.Switch.Expression
+}
+
+// selectBranch returns the name and steps of the branch to take based on the value of the condition. The answer of a
+// model to an if condition must be true or false, while the value of an expression uses the truthiness of expressions.
+func selectBranch(step types.Step, value string, fromModel bool) (string, []types.Step, error) {
+	if step.If != nil {
+		isTrue := expression.IsTrue(value)
+		if fromModel {
+			var err error
+			if isTrue, err = expression.ParseBool(value); err != nil {
+				return "", nil, fmt.Errorf("failed to evaluate condition %q: %w", step.If.Condition, err)
+			}
+		}
+		if isTrue {
+			return branchThen, step.If.Steps, nil
+		}
+		return branchElse, step.If.Else, nil
+	}
+
+	value = normalizeCaseValue(value)
+	for _, c := range step.Switch.Cases {
+		if normalizeCaseValue(c.Value) == value {
+			return c.Value, c.Steps, nil
+		}
+	}
+	return branchDefault, step.Switch.Default, nil
+}
+
+func normalizeCaseValue(value string) string {
+	return strings.ToLower(strings.Trim(strings.TrimSpace(value), ".!\"'`*"))
+}
+
+func defineConditionStep(rootStep *v1.WorkflowStep) *v1.WorkflowStep {
+	var prompt string
+	if rootStep.Spec.Step.If != nil {
+		prompt = ifPrompt(rootStep.Spec.Step.If.Condition)
+	} else {
+		prompt = switchPrompt(rootStep.Spec.Step.Switch)
+	}
+
+	return newChildStep(rootStep, rootStep.Spec.AfterWorkflowStepName, types.Step{
+		ID:   rootStep.Spec.Step.ID + "{condition}",
+		Step: prompt,
+	})
+}
+
+func ifPrompt(condition string) string {
+	return fmt.Sprintf(`
+	Based on the conversation so far, decide whether the following condition is true or false:
+	%q
+
+	If the information needed is not already available in the chat history, call any tools you need in order to find it.
+	Respond with only the single word true or false.
+	`, condition)
+}
+
+func switchPrompt(s *types.StepSwitch) string {
+	values := make([]string, 0, len(s.Cases))
+	for _, c := range s.Cases {
+		values = append(values, fmt.Sprintf("%q", c.Value))
+	}
+
+	return fmt.Sprintf(`
+	Based on the conversation so far, determine the following:
+	%q
+
+	If the information needed is not already available in the chat history, call any tools you need in order to find it.
+	Respond with only one of the following values, exactly as written: %s
+	If none of the values apply, respond with only the word none.
+	`, s.Value, strings.Join(values, ", "))
+}
@@ -0,0 +1,73 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/pkg/expression"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveReference looks up a reference used in an expression for the given step.
+func resolveReference(ctx context.Context, c kclient.Client, step *v1.WorkflowStep, ref string) (string, error) {
+	var (
+		value string
+		path  string
+		err   error
+	)
+
+	switch {
+	case ref == "input" || strings.HasPrefix(ref, "input."):
+		path = strings.TrimPrefix(strings.TrimPrefix(ref, "input"), ".")
+		var wfe v1.WorkflowExecution
+		if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
+			return "", err
+		}
+		value = wfe.Spec.Input
+	case ref == "previous.output" || strings.HasPrefix(ref, "previous.output."):
+		path = strings.TrimPrefix(strings.TrimPrefix(ref, "previous.output"), ".")
+		if step.Spec.AfterWorkflowStepName == "" {
+			return "", nil
+		}
+		value, err = stepOutput(ctx, c, step.Namespace, step.Spec.AfterWorkflowStepName)
+	case strings.HasPrefix(ref, "steps."):
+		stepID, rest, ok := strings.Cut(strings.TrimPrefix(ref, "steps."), ".output")
+		if !ok || (rest != "" && !strings.HasPrefix(rest, ".")) {
+			return "", fmt.Errorf("invalid step reference %q: expected steps.<id>.output", ref)
+		}
+		path = strings.TrimPrefix(rest, ".")
+		value, err = stepOutput(ctx, c, step.Namespace, stepName(step.Spec.WorkflowExecutionName, stepID))
+	default:
+		return "", fmt.Errorf("unknown reference %q: expected a quoted string, input, previous.output, or steps.<id>.output", ref)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return expression.LookupPath(value, path)
+}
+
+func stepOutput(ctx context.Context, c kclient.Client, namespace, name string) (string, error) {
+	var step v1.WorkflowStep
+	if err := c.Get(ctx, router.Key(namespace, name), &step); err != nil {
+		return "", kclient.IgnoreNotFound(err)
+	}
+
+	if step.Status.StructuredOutput != "" {
+		return step.Status.StructuredOutput, nil
+	}
+
+	if step.Status.LastRunName == "" {
+		return "", nil
+	}
+
+	var run v1.Run
+	if err := c.Get(ctx, router.Key(namespace, step.Status.LastRunName), &run); err != nil {
+		return "", kclient.IgnoreNotFound(err)
+	}
+
+	return run.Status.Output, nil
+}
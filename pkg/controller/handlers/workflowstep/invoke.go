@@ -1,6 +1,9 @@
 package workflowstep
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/nah/pkg/untriggered"
 	"github.com/obot-platform/obot/apiclient/types"
@@ -9,10 +12,13 @@ import (
 	"k8s.io/client-go/util/retry"
 )
 
-func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
+const (
+	defaultBackoff      = 10 * time.Second
+	maxBackoffDoublings = 10
+)
+
+func (h *Handler) RunInvoke(req router.Request, resp router.Response) error {
 	var (
-		ctx         = req.Ctx
-		client      = req.Client
 		step        = req.Object.(*v1.WorkflowStep)
 		lastRunName string
 	)
@@ -22,64 +28,180 @@ func (h *Handler) RunInvoke(req router.Request, _ router.Response) error {
 		return nil
 	}
 
+	if step.Spec.Step.If != nil || step.Spec.Step.Switch != nil {
+		// This will get picked up by the branch handler.
+		return nil
+	}
+
+	if len(step.Spec.Step.Parallel) > 0 {
+		// This will get picked up by the parallel handler.
+		return nil
+	}
+
+	if step.Spec.Step.Approval != nil {
+		// This will get picked up by the approval handler.
+		return nil
+	}
+
+	if step.Spec.Step.Task != nil {
+		// This will get picked up by the task handler.
+		return nil
+	}
+
 	if step.Spec.AfterWorkflowStepName != "" {
 		var previousStep v1.WorkflowStep
-		if err := client.Get(ctx, router.Key(step.Namespace, step.Spec.AfterWorkflowStepName), &previousStep); err != nil {
+		if err := req.Get(&previousStep, step.Namespace, step.Spec.AfterWorkflowStepName); err != nil {
 			return err
 		}
 		lastRunName = previousStep.Status.LastRunName
 	}
 
+	timeout, backoff, err := parseStepDurations(step.Spec.Step)
+	if err != nil {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = err.Error()
+		return nil
+	}
+
 	var run v1.Run
-	if len(step.Status.RunNames) == 0 {
-		invokeResp, err := h.invoker.Step(ctx, req.Client, step, invoke.StepOptions{
+	if len(step.Status.RunNames) > 0 {
+		if err := req.Get(&run, step.Namespace, step.Status.RunNames[len(step.Status.RunNames)-1]); err != nil {
+			return err
+		}
+	}
+
+	if len(step.Status.RunNames) == 0 || shouldRetry(step, &run) {
+		if wait := retryWait(step, &run, backoff); wait > 0 {
+			step.Status.State = types.WorkflowStateRunning
+			resp.RetryAfter(wait)
+			return nil
+		}
+
+		newRun, err := h.invokeStep(req, step, invoke.StepOptions{
 			PreviousRunName: lastRunName,
-		})
+			Timeout:         timeout,
+		}, false)
 		if err != nil {
 			return err
 		}
-		defer invokeResp.Close()
-
-		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-			if err := client.Get(ctx, router.Key(step.Namespace, step.Name), untriggered.UncachedGet(step)); err != nil {
+		run = *newRun
+	} else if run.Status.State == v1.Continue && step.Spec.Step.OutputSchema != "" && step.Status.StructuredOutput == "" {
+		output, err := validateOutput(step.Spec.Step.OutputSchema, run.Status.Output)
+		if err == nil {
+			step.Status.StructuredOutput = output
+		} else if step.Status.OutputRetries < maxOutputRetries {
+			// Continue the conversation of the run so the model can correct its response.
+			newRun, err := h.invokeStep(req, step, invoke.StepOptions{
+				PreviousRunName: run.Name,
+				Timeout:         timeout,
+				Input:           correctionPrompt(step.Spec.Step.OutputSchema, err),
+			}, true)
+			if err != nil {
 				return err
 			}
-			step.Status.ThreadName = invokeResp.Thread.Name
-			step.Status.RunNames = []string{invokeResp.Run.Name}
-			return client.Status().Update(ctx, step)
-		})
-		if err != nil {
-			return err
+			run = *newRun
+		} else {
+			step.Status.State = types.WorkflowStateError
+			step.Status.LastRunName = run.Name
+			step.Status.Error = err.Error()
+			return nil
 		}
+	}
 
-		run = *invokeResp.Run
-	} else {
-		if err := req.Get(&run, step.Namespace, step.Status.RunNames[0]); err != nil {
+	h.setStepStateFromRun(step, &run)
+
+	return nil
+}
+
+// invokeStep starts a new run for the step and records it in the status of the step.
+func (h *Handler) invokeStep(req router.Request, step *v1.WorkflowStep, opts invoke.StepOptions, outputRetry bool) (*v1.Run, error) {
+	invokeResp, err := h.invoker.Step(req.Ctx, req.Client, step, opts)
+	if err != nil {
+		return nil, err
+	}
+	return h.recordRun(req, step, invokeResp, outputRetry)
+}
+
+// recordRun records a new run of the step in the status of the step.
+func (h *Handler) recordRun(req router.Request, step *v1.WorkflowStep, invokeResp *invoke.Response, outputRetry bool) (*v1.Run, error) {
+	defer invokeResp.Close()
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := req.Client.Get(req.Ctx, router.Key(step.Namespace, step.Name), untriggered.UncachedGet(step)); err != nil {
 			return err
 		}
+		step.Status.ThreadName = invokeResp.Thread.Name
+		step.Status.RunNames = append(step.Status.RunNames, invokeResp.Run.Name)
+		if outputRetry {
+			step.Status.OutputRetries++
+		}
+		return req.Client.Status().Update(req.Ctx, step)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	h.setStepStateFromRun(step, &run)
+	return invokeResp.Run, nil
+}
 
-	return nil
+// shouldRetry returns whether the last run of the step failed and the step has retries left.
+func shouldRetry(step *v1.WorkflowStep, run *v1.Run) bool {
+	return run.Status.State == v1.Error && attempts(step) <= step.Spec.Step.Retries
+}
+
+// attempts returns the number of runs of the step, not counting the runs that corrected its output.
+func attempts(step *v1.WorkflowStep) int {
+	return len(step.Status.RunNames) - step.Status.OutputRetries
+}
+
+// retryWait returns how long to wait before the next attempt of the step. The wait doubles for each failed attempt.
+func retryWait(step *v1.WorkflowStep, run *v1.Run, backoff time.Duration) time.Duration {
+	if attempts(step) <= 0 || run.Status.EndTime.IsZero() {
+		return 0
+	}
+	wait := backoff << min(attempts(step)-1, maxBackoffDoublings)
+	return time.Until(run.Status.EndTime.Add(wait))
+}
+
+func parseStepDurations(step types.Step) (timeout, backoff time.Duration, err error) {
+	if step.Timeout != "" {
+		timeout, err = time.ParseDuration(step.Timeout)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid timeout %q: %w", step.Timeout, err)
+		}
+	}
+
+	backoff = defaultBackoff
+	if step.Backoff != "" {
+		backoff, err = time.ParseDuration(step.Backoff)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid backoff %q: %w", step.Backoff, err)
+		}
+	}
+
+	return timeout, backoff, nil
 }
 
 func (h *Handler) setStepStateFromRun(step *v1.WorkflowStep, run *v1.Run) {
+	lastRunName := step.Status.RunNames[len(step.Status.RunNames)-1]
 	switch run.Status.State {
 	case v1.Finished:
 		step.Status.State = types.WorkflowStateError
-		step.Status.LastRunName = step.Status.RunNames[0]
+		step.Status.LastRunName = lastRunName
 		step.Status.Error = "Aborted"
 		if run.Status.Output != "" {
 			step.Status.Error += ": " + run.Status.Output
 		}
 	case v1.Continue:
 		step.Status.State = types.WorkflowStateComplete
-		step.Status.LastRunName = step.Status.RunNames[0]
+		step.Status.LastRunName = lastRunName
 		step.Status.Error = ""
 	case v1.Error:
 		step.Status.State = types.WorkflowStateError
-		step.Status.LastRunName = step.Status.RunNames[0]
+		step.Status.LastRunName = lastRunName
 		step.Status.Error = run.Status.Error
+		if attempts := attempts(step); attempts > 1 {
+			step.Status.Error = fmt.Sprintf("failed after %d attempts: %s", attempts, run.Status.Error)
+		}
 	}
 }
@@ -65,9 +65,21 @@ func (h *Handler) RunLoop(req router.Request, _ router.Response) (err error) {
 		return nil
 	}
 
-	lastStepName := dataStep.Name
+	var (
+		lanes   = newLanes(dataStep.Name, max(rootStep.Spec.Step.Concurrency, 1), len(data))
+		tails   []kclient.Object
+		threads []kclient.Object
+	)
+	if rootStep.Spec.Step.Concurrency > 1 {
+		thread, err := workflowExecutionThread(req.Ctx, req.Client, rootStep)
+		if err != nil {
+			return err
+		}
+		threads = lanes.defineThreads(rootStep, thread)
+	}
+
 	for elementIndex, element := range data {
-		steps, err := defineLoop(elementIndex, element, lastStepName, rootStep)
+		steps, err := defineLoop(elementIndex, element, lanes.after(elementIndex), lanes.thread(elementIndex), rootStep)
 		if err != nil {
 			return err
 		}
@@ -75,10 +87,24 @@ func (h *Handler) RunLoop(req router.Request, _ router.Response) (err error) {
 		objects = append(objects, steps...)
 
 		if len(steps) > 0 {
-			lastStepName = steps[len(steps)-1].GetName()
+			lanes.set(elementIndex, steps[len(steps)-1].GetName())
+			tails = append(tails, steps[len(steps)-1])
 		}
 	}
 
+	if rootStep.Spec.Step.Concurrency > 1 && len(tails) > 0 {
+		joinStep, complete, err := join(req.Ctx, req.Client, rootStep, dataStep.Name, "", objects, tails)
+		objects = append(objects, threads...)
+		if joinStep != nil {
+			objects = append(objects, joinStep)
+		}
+		if complete {
+			completeResponse = true
+			h.deleteDataFile(req.Ctx, workspaceID, fileName)
+		}
+		return err
+	}
+
 	runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, rootStep.Spec.WorkflowGeneration, objects...)
 	if err != nil {
 		return err
@@ -99,15 +125,21 @@ func (h *Handler) RunLoop(req router.Request, _ router.Response) (err error) {
 	rootStep.Status.State = types.WorkflowStateComplete
 	rootStep.Status.LastRunName = runName
 
+	h.deleteDataFile(req.Ctx, workspaceID, fileName)
+	return nil
+}
+
+func (h *Handler) deleteDataFile(ctx context.Context, workspaceID, fileName string) {
 	// We ignore the error here because it does not really matter if we fail to delete the file.
 	// We're just making a best effort to clean up after ourselves.
-	_ = h.gptscriptClient.DeleteFileInWorkspace(req.Ctx, fileName, gptscript.DeleteFileInWorkspaceOptions{
+	_ = h.gptscriptClient.DeleteFileInWorkspace(ctx, fileName, gptscript.DeleteFileInWorkspaceOptions{
 		WorkspaceID: workspaceID,
 	})
-	return nil
 }
 
-func defineLoop(elementIndex int, element string, dataStepName string, rootStep *v1.WorkflowStep) (result []kclient.Object, _ error) {
+// defineLoop returns the steps for an element of the loop. The steps run on the given thread, if it is set, and on the
+// thread of the root step otherwise.
+func defineLoop(elementIndex int, element string, dataStepName, threadName string, rootStep *v1.WorkflowStep) (result []kclient.Object, _ error) {
 	var previousStepName string
 	for i, s := range rootStep.Spec.Step.Loop {
 		afterStepName := dataStepName
@@ -118,10 +150,13 @@ func defineLoop(elementIndex int, element string, dataStepName string, rootStep
 			s = elementPrompt(element, s)
 		}
 
-		newStep := NewStep(rootStep.Namespace, rootStep.Spec.WorkflowExecutionName, afterStepName, rootStep.Spec.WorkflowGeneration, types.Step{
+		newStep := newChildStep(rootStep, afterStepName, types.Step{
 			ID:   fmt.Sprintf("%s{element=%d}{step=%d}", rootStep.Spec.Step.ID, elementIndex, i),
 			Step: s,
 		})
+		if threadName != "" {
+			newStep.Spec.ThreadName = threadName
+		}
 		result = append(result, newStep)
 		previousStepName = newStep.Name
 	}
@@ -140,7 +175,7 @@ func elementPrompt(element, prompt string) string {
 }
 
 func defineDataStep(rootStep *v1.WorkflowStep, fileName string) *v1.WorkflowStep {
-	return NewStep(rootStep.Namespace, rootStep.Spec.WorkflowExecutionName, rootStep.Spec.AfterWorkflowStepName, rootStep.Spec.WorkflowGeneration, types.Step{
+	return newChildStep(rootStep, rootStep.Spec.AfterWorkflowStepName, types.Step{
 		ID:   rootStep.Spec.Step.ID + "{loopdata}",
 		Step: dataPrompt(rootStep.Spec.Step.Step, fileName),
 	})
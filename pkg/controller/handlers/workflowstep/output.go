@@ -0,0 +1,82 @@
+package workflowstep
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// maxOutputRetries is the number of times the model is asked to correct output that does not match the output schema.
+const maxOutputRetries = 2
+
+var fencedJSONRegexp = regexp.MustCompile("(?s)```(?:json)?\\s*\\n(.*?)\\n\\s*```")
+
+// ParseOutputSchema parses the JSON Schema that the output of a step must match.
+func ParseOutputSchema(schemaJSON string) (*gojsonschema.Schema, error) {
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schemaJSON))
+	if err != nil {
+		return nil, fmt.Errorf("invalid output schema: %w", err)
+	}
+	return schema, nil
+}
+
+// validateOutput extracts the JSON value from the output of a step and validates it against the schema.
+// The normalized JSON is returned when it is valid.
+func validateOutput(schemaJSON, output string) (string, error) {
+	schema, err := ParseOutputSchema(schemaJSON)
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := extractJSON(output)
+	if !ok {
+		return "", fmt.Errorf("output is not JSON")
+	}
+
+	result, err := schema.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return "", fmt.Errorf("output could not be validated: %w", err)
+	}
+	if !result.Valid() {
+		errs := make([]string, 0, len(result.Errors()))
+		for _, resultErr := range result.Errors() {
+			errs = append(errs, resultErr.String())
+		}
+		return "", fmt.Errorf("output does not match the schema: %s", strings.Join(errs, "; "))
+	}
+
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+// extractJSON finds the JSON value in output, which may be wrapped in a markdown code block or surrounded by prose.
+func extractJSON(output string) (any, bool) {
+	output = strings.TrimSpace(output)
+	candidates := []string{output}
+	if match := fencedJSONRegexp.FindStringSubmatch(output); match != nil {
+		candidates = append(candidates, match[1])
+	}
+	if start, end := strings.IndexAny(output, "{["), strings.LastIndexAny(output, "}]"); start >= 0 && end > start {
+		candidates = append(candidates, output[start:end+1])
+	}
+
+	for _, candidate := range candidates {
+		var value any
+		if err := json.Unmarshal([]byte(candidate), &value); err == nil {
+			return value, true
+		}
+	}
+	return nil, false
+}
+
+func correctionPrompt(schemaJSON string, validationErr error) string {
+	return fmt.Sprintf(`
+	Your previous response could not be used: %v
+
+	Respond again with only JSON that matches the following JSON Schema:
+	%s
+	`, validationErr, schemaJSON)
+}
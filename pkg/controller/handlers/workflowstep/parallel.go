@@ -0,0 +1,267 @@
+package workflowstep
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunParallel(req router.Request, _ router.Response) (err error) {
+	rootStep := req.Object.(*v1.WorkflowStep)
+
+	if len(rootStep.Spec.Step.Parallel) == 0 {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	rootStep.Status.Error = ""
+
+	thread, err := workflowExecutionThread(req.Ctx, req.Client, rootStep)
+	if err != nil {
+		return err
+	}
+
+	steps, tails, threads := defineParallel(rootStep, thread)
+	objects = append(objects, threads...)
+	objects = append(objects, steps...)
+
+	joinStep, complete, err := join(req.Ctx, req.Client, rootStep, rootStep.Spec.AfterWorkflowStepName, rootStep.Spec.Step.Step, steps, tails)
+	if joinStep != nil {
+		objects = append(objects, joinStep)
+	}
+	completeResponse = complete
+	return err
+}
+
+// defineParallel returns the steps of a parallel step, the last step of each of its lanes, and the threads of its lanes.
+func defineParallel(rootStep *v1.WorkflowStep, thread *v1.Thread) (steps, tails, threads []kclient.Object) {
+	lanes := newLanes(rootStep.Spec.AfterWorkflowStepName, rootStep.Spec.Step.Concurrency, len(rootStep.Spec.Step.Parallel))
+	threads = lanes.defineThreads(rootStep, thread)
+	for i, step := range rootStep.Spec.Step.Parallel {
+		newStep := newChildStep(rootStep, lanes.after(i), step)
+		if threadName := lanes.thread(i); threadName != "" {
+			newStep.Spec.ThreadName = threadName
+		}
+		lanes.set(i, newStep.Name)
+		steps = append(steps, newStep)
+		tails = append(tails, newStep)
+	}
+	return steps, tails, threads
+}
+
+// workflowExecutionThread returns the thread of the workflow execution of the step.
+func workflowExecutionThread(ctx context.Context, c kclient.Client, step *v1.WorkflowStep) (*v1.Thread, error) {
+	var wfe v1.WorkflowExecution
+	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
+		return nil, err
+	}
+
+	var thread v1.Thread
+	return &thread, c.Get(ctx, router.Key(step.Namespace, wfe.Status.ThreadName), &thread)
+}
+
+// join waits for all the steps to complete and then runs a step that receives the outputs of the tails.
+// The join step is returned once it is defined.
+func join(ctx context.Context, c kclient.Client, rootStep *v1.WorkflowStep, afterStepName, instructions string, steps, tails []kclient.Object) (_ *v1.WorkflowStep, complete bool, _ error) {
+	outputs, errMsg, state, err := getStateFromParallelSteps(ctx, c, rootStep.Spec.WorkflowGeneration, steps, tails)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if state.IsBlocked() {
+		rootStep.Status.State = state
+		rootStep.Status.Error = errMsg
+		return nil, false, nil
+	}
+
+	if state != types.WorkflowStateComplete {
+		rootStep.Status.State = state
+		return nil, false, nil
+	}
+
+	joinStep := newChildStep(rootStep, afterStepName, types.Step{
+		ID:   rootStep.Spec.Step.ID + "{join}",
+		Step: joinPrompt(outputs, instructions),
+	})
+
+	runName, errMsg, newState, err := GetStateFromSteps(ctx, c, rootStep.Spec.WorkflowGeneration, joinStep)
+	if err != nil {
+		return joinStep, false, err
+	}
+
+	if newState.IsBlocked() {
+		rootStep.Status.State = newState
+		rootStep.Status.Error = errMsg
+		return joinStep, false, nil
+	}
+
+	if newState != types.WorkflowStateComplete {
+		rootStep.Status.State = types.WorkflowStateRunning
+		return joinStep, false, nil
+	}
+
+	rootStep.Status.State = types.WorkflowStateComplete
+	rootStep.Status.LastRunName = runName
+	return joinStep, true, nil
+}
+
+// getStateFromParallelSteps returns the state of steps that do not wait on each other. The steps are complete once
+// all of them are complete, at which point the outputs of the tails are returned.
+func getStateFromParallelSteps(ctx context.Context, client kclient.Client, generation int64, steps, tails []kclient.Object) (outputs []string, _ string, _ types.WorkflowState, _ error) {
+	var running bool
+	for _, obj := range steps {
+		step := obj.(*v1.WorkflowStep).DeepCopy()
+		if err := client.Get(ctx, kclient.ObjectKeyFromObject(step), step); apierrors.IsNotFound(err) {
+			running = true
+			continue
+		} else if err != nil {
+			return nil, "", "", err
+		} else if step.Status.State.IsBlocked() {
+			return nil, step.Status.Error, step.Status.State, nil
+		}
+		if step.Status.WorkflowGeneration != generation || step.Status.State != types.WorkflowStateComplete {
+			running = true
+		}
+	}
+
+	if running {
+		return nil, "", types.WorkflowStateRunning, nil
+	}
+
+	for _, obj := range tails {
+		step := obj.(*v1.WorkflowStep).DeepCopy()
+		if err := client.Get(ctx, kclient.ObjectKeyFromObject(step), step); err != nil {
+			return nil, "", "", err
+		}
+
+		if step.Status.StructuredOutput != "" {
+			outputs = append(outputs, step.Status.StructuredOutput)
+			continue
+		}
+
+		var run v1.Run
+		if err := client.Get(ctx, router.Key(step.Namespace, step.Status.LastRunName), &run); err != nil {
+			return nil, "", "", err
+		}
+		outputs = append(outputs, run.Status.Output)
+	}
+
+	return outputs, "", types.WorkflowStateComplete, nil
+}
+
+// lanes tracks the last step and the thread of each chain of steps that run at the same time.
+type lanes struct {
+	first   string
+	last    []string
+	threads []string
+}
+
+func newLanes(first string, concurrency, count int) *lanes {
+	if concurrency <= 0 || concurrency > count {
+		concurrency = count
+	}
+	return &lanes{
+		first: first,
+		last:  make([]string, max(concurrency, 1)),
+	}
+}
+
+// after returns the name of the step that the i-th chain should run after.
+func (l *lanes) after(i int) string {
+	if last := l.last[i%len(l.last)]; last != "" {
+		return last
+	}
+	return l.first
+}
+
+func (l *lanes) set(i int, name string) {
+	l.last[i%len(l.last)] = name
+}
+
+// thread returns the thread that the i-th chain runs on, or an empty string if it runs on the thread of the root step.
+func (l *lanes) thread(i int) string {
+	if len(l.threads) == 0 {
+		return ""
+	}
+	return l.threads[i%len(l.threads)]
+}
+
+// defineThreads defines a thread for each chain when more than one chain runs at a time, so that the chains don't
+// start runs on the same thread. The threads are copies of the thread of the workflow execution, and are deleted
+// along with it.
+func (l *lanes) defineThreads(rootStep *v1.WorkflowStep, thread *v1.Thread) (result []kclient.Object) {
+	if len(l.last) < 2 {
+		return nil
+	}
+
+	l.threads = make([]string, len(l.last))
+	for i := range l.last {
+		laneThread := &v1.Thread{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name.SafeConcatName(system.ThreadPrefix+strings.TrimPrefix(rootStep.Name, system.WorkflowStepPrefix), "lane", strconv.Itoa(i)),
+				Namespace:  rootStep.Namespace,
+				Finalizers: []string{v1.ThreadFinalizer},
+			},
+			Spec: v1.ThreadSpec{
+				ParentThreadName:      thread.Spec.ParentThreadName,
+				AgentName:             thread.Spec.AgentName,
+				WorkflowName:          thread.Spec.WorkflowName,
+				WorkflowExecutionName: thread.Spec.WorkflowExecutionName,
+				UserID:                thread.Spec.UserID,
+				Env:                   thread.Spec.Env,
+				SystemTools:           thread.Spec.SystemTools,
+				// The thread only exists to run the steps of the lane, so it is not shown as a thread of the project.
+				SystemTask: true,
+			},
+		}
+		l.threads[i] = laneThread.Name
+		result = append(result, laneThread)
+	}
+	return result
+}
+
+func joinPrompt(outputs []string, instructions string) string {
+	if instructions == "" {
+		instructions = "Combine the results into a single response."
+	}
+
+	results := make([]string, 0, len(outputs))
+	for i, output := range outputs {
+		results = append(results, fmt.Sprintf("Result %d: %s", i+1, output))
+	}
+
+	return fmt.Sprintf(`
+	The following are the results of steps that ran at the same time:
+
+	%s
+
+	Based on the results, follow the instructions below.
+
+	Instructions: %s
+	`, strings.Join(results, "\n\n\t"), instructions)
+}
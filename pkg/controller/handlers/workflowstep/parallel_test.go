@@ -0,0 +1,124 @@
+package workflowstep
+
+import (
+	"testing"
+
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDefineParallel(t *testing.T) {
+	thread := &v1.Thread{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "t1wfe",
+			Namespace: "default",
+		},
+		Spec: v1.ThreadSpec{
+			ParentThreadName:      "t1project",
+			AgentName:             "a1agent",
+			WorkflowName:          "w1task",
+			WorkflowExecutionName: "we1run",
+			UserID:                "1",
+		},
+	}
+
+	newRootStep := func(concurrency int) *v1.WorkflowStep {
+		return NewStep("default", "we1run", "ws1run-before", 1, types.Step{
+			ID:          "parallel",
+			Concurrency: concurrency,
+			Parallel: []types.Step{
+				{ID: "a", Step: "a"},
+				{ID: "b", Step: "b"},
+				{ID: "c", Step: "c"},
+			},
+		})
+	}
+
+	t.Run("each lane runs on its own thread", func(t *testing.T) {
+		steps, tails, threads := defineParallel(newRootStep(0), thread)
+		require.Len(t, steps, 3)
+		require.Len(t, tails, 3)
+		require.Len(t, threads, 3)
+
+		threadNames := map[string]bool{}
+		for i, obj := range steps {
+			step := obj.(*v1.WorkflowStep)
+			require.Equal(t, "ws1run-before", step.Spec.AfterWorkflowStepName)
+			require.Equal(t, threads[i].GetName(), step.Spec.ThreadName)
+			require.NotEqual(t, thread.Name, step.Spec.ThreadName)
+			threadNames[step.Spec.ThreadName] = true
+		}
+		require.Len(t, threadNames, 3)
+
+		for _, obj := range threads {
+			laneThread := obj.(*v1.Thread)
+			require.True(t, laneThread.Spec.SystemTask)
+			require.Equal(t, thread.Spec.ParentThreadName, laneThread.Spec.ParentThreadName)
+			require.Equal(t, thread.Spec.AgentName, laneThread.Spec.AgentName)
+			require.Equal(t, thread.Spec.WorkflowExecutionName, laneThread.Spec.WorkflowExecutionName)
+			require.Equal(t, thread.Spec.UserID, laneThread.Spec.UserID)
+		}
+	})
+
+	t.Run("steps in the same lane share its thread and run in order", func(t *testing.T) {
+		steps, _, threads := defineParallel(newRootStep(2), thread)
+		require.Len(t, threads, 2)
+
+		a, b, c := steps[0].(*v1.WorkflowStep), steps[1].(*v1.WorkflowStep), steps[2].(*v1.WorkflowStep)
+		require.NotEqual(t, a.Spec.ThreadName, b.Spec.ThreadName)
+		require.Equal(t, a.Spec.ThreadName, c.Spec.ThreadName)
+		require.Equal(t, a.Name, c.Spec.AfterWorkflowStepName)
+		require.Equal(t, "ws1run-before", b.Spec.AfterWorkflowStepName)
+	})
+
+	t.Run("a single lane runs on the thread of the root step", func(t *testing.T) {
+		steps, _, threads := defineParallel(newRootStep(1), thread)
+		require.Empty(t, threads)
+		for _, obj := range steps {
+			require.Empty(t, obj.(*v1.WorkflowStep).Spec.ThreadName)
+		}
+	})
+
+	t.Run("steps of a lane inherit its thread", func(t *testing.T) {
+		steps, _, _ := defineParallel(newRootStep(0), thread)
+		laneStep := steps[0].(*v1.WorkflowStep)
+
+		child := newChildStep(laneStep, laneStep.Spec.AfterWorkflowStepName, types.Step{ID: "a{condition}"})
+		require.Equal(t, laneStep.Spec.ThreadName, child.Spec.ThreadName)
+	})
+}
+
+func TestDefineLoopLanes(t *testing.T) {
+	rootStep := NewStep("default", "we1run", "", 1, types.Step{
+		ID:          "loop",
+		Concurrency: 2,
+		Loop:        []string{"first", "second"},
+	})
+	thread := &v1.Thread{ObjectMeta: metav1.ObjectMeta{Name: "t1wfe", Namespace: "default"}}
+
+	lanes := newLanes("ws1run-loopdata", rootStep.Spec.Step.Concurrency, 3)
+	threads := lanes.defineThreads(rootStep, thread)
+	require.Len(t, threads, 2)
+
+	var elements [][]*v1.WorkflowStep
+	for i := range 3 {
+		objs, err := defineLoop(i, "element", lanes.after(i), lanes.thread(i), rootStep)
+		require.NoError(t, err)
+
+		var steps []*v1.WorkflowStep
+		for _, obj := range objs {
+			steps = append(steps, obj.(*v1.WorkflowStep))
+		}
+		lanes.set(i, steps[len(steps)-1].Name)
+		elements = append(elements, steps)
+	}
+
+	for i, steps := range elements {
+		for _, step := range steps {
+			require.Equal(t, threads[i%2].GetName(), step.Spec.ThreadName)
+		}
+	}
+	require.Equal(t, elements[0][1].Name, elements[2][0].Spec.AfterWorkflowStepName)
+}
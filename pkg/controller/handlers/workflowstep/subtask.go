@@ -0,0 +1,197 @@
+package workflowstep
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/apply"
+	"github.com/obot-platform/nah/pkg/name"
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/expression"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (h *Handler) RunTask(req router.Request, _ router.Response) (err error) {
+	step := req.Object.(*v1.WorkflowStep)
+
+	task := step.Spec.Step.Task
+	if task == nil {
+		return nil
+	}
+
+	var (
+		completeResponse bool
+		objects          []kclient.Object
+	)
+	defer func() {
+		apply := apply.New(req.Client)
+		if !completeResponse {
+			apply.WithNoPrune()
+		}
+		if applyErr := apply.Apply(req.Ctx, req.Object, objects...); applyErr != nil && err == nil {
+			err = applyErr
+		}
+	}()
+
+	// reset
+	step.Status.Error = ""
+
+	newWFE, err := h.defineTaskExecution(req, step)
+	if err != nil {
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = err.Error()
+		return nil
+	}
+	objects = append(objects, newWFE)
+
+	var wfe v1.WorkflowExecution
+	if err := req.Get(&wfe, newWFE.Namespace, newWFE.Name); apierrors.IsNotFound(err) {
+		step.Status.State = types.WorkflowStateRunning
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	switch {
+	case wfe.Status.State == types.WorkflowStateError:
+		step.Status.State = types.WorkflowStateError
+		step.Status.Error = fmt.Sprintf("task %s failed: %s", task.ID, wfe.Status.Error)
+		return nil
+	case wfe.Status.State.IsBlocked():
+		step.Status.State = wfe.Status.State
+		step.Status.Error = fmt.Sprintf("task %s is blocked: %s", task.ID, wfe.Status.Error)
+		return nil
+	case wfe.Status.State != types.WorkflowStateComplete:
+		step.Status.State = types.WorkflowStateRunning
+		return nil
+	}
+
+	if step.Spec.Step.Step == "" {
+		// Without instructions, the output of this step is the output of the task as is, and the model is not run.
+		lastRunName, err := previousLastRunName(req, step)
+		if err != nil {
+			return err
+		}
+
+		completeResponse = true
+		step.Status.State = types.WorkflowStateComplete
+		step.Status.LastRunName = lastRunName
+		step.Status.StructuredOutput = wfe.Status.Output
+		return nil
+	}
+
+	// Give the output of the task to the model along with the instructions of the step.
+	resultStep := newChildStep(step, step.Spec.AfterWorkflowStepName, types.Step{
+		ID:   step.Spec.Step.ID + "{result}",
+		Step: taskResultPrompt(task.ID, wfe.Status.Output, step.Spec.Step.Step),
+	})
+	objects = append(objects, resultStep)
+
+	runName, errMsg, newState, err := GetStateFromSteps(req.Ctx, req.Client, step.Spec.WorkflowGeneration, resultStep)
+	if err != nil {
+		return err
+	}
+
+	if newState.IsBlocked() {
+		step.Status.State = newState
+		step.Status.Error = errMsg
+		return nil
+	}
+
+	if newState != types.WorkflowStateComplete {
+		step.Status.State = types.WorkflowStateRunning
+		return nil
+	}
+
+	completeResponse = true
+	step.Status.State = types.WorkflowStateComplete
+	step.Status.LastRunName = runName
+	return nil
+}
+
+// defineTaskExecution returns the execution of the task of the step with the params of the step evaluated.
+// An error is returned if the task cannot be run from this step.
+func (h *Handler) defineTaskExecution(req router.Request, step *v1.WorkflowStep) (*v1.WorkflowExecution, error) {
+	task := step.Spec.Step.Task
+
+	var parent v1.WorkflowExecution
+	if err := req.Get(&parent, step.Namespace, step.Spec.WorkflowExecutionName); err != nil {
+		return nil, err
+	}
+
+	var parentWorkflow v1.Workflow
+	if err := req.Get(&parentWorkflow, step.Namespace, parent.Spec.WorkflowName); err != nil {
+		return nil, err
+	}
+
+	var workflow v1.Workflow
+	if err := req.Get(&workflow, step.Namespace, task.ID); apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("task %s not found", task.ID)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if workflow.Spec.ThreadName != parentWorkflow.Spec.ThreadName {
+		return nil, fmt.Errorf("task %s is not in the same project", task.ID)
+	}
+
+	breadCrumb := append(strings.Split(parent.Spec.TaskBreakCrumb, ","), parent.Spec.WorkflowName)
+	if slices.Contains(breadCrumb, workflow.Name) {
+		return nil, fmt.Errorf("task %s cannot be run because it is already running in this chain of tasks", task.ID)
+	}
+
+	var input string
+	if len(task.Params) > 0 {
+		params := make(map[string]string, len(task.Params))
+		for param, expr := range task.Params {
+			if _, ok := workflow.Spec.Manifest.Params[param]; !ok {
+				return nil, fmt.Errorf("task %s does not have a parameter named %s", task.ID, param)
+			}
+			value, err := expression.Evaluate(expr, func(ref string) (string, error) {
+				return resolveReference(req.Ctx, req.Client, step, ref)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for parameter %s: %w", param, err)
+			}
+			params[param] = value
+		}
+
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, err
+		}
+		input = string(data)
+	}
+
+	return &v1.WorkflowExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.SafeConcatName(system.WorkflowExecutionPrefix+strings.TrimPrefix(step.Name, system.WorkflowStepPrefix), fmt.Sprint(step.Spec.WorkflowGeneration)),
+			Namespace: step.Namespace,
+		},
+		Spec: v1.WorkflowExecutionSpec{
+			WorkflowName:   workflow.Name,
+			Input:          input,
+			ThreadName:     workflow.Spec.ThreadName,
+			TaskBreakCrumb: strings.Trim(strings.Join(breadCrumb, ","), ","),
+		},
+	}, nil
+}
+
+func taskResultPrompt(taskID, output, instructions string) string {
+	return fmt.Sprintf(`
+	The task %q was run and returned the following output:
+
+	%s
+
+	Based on the output, follow the instructions below.
+
+	Instructions: %s
+	`, taskID, output, instructions)
+}
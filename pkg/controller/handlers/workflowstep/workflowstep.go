@@ -31,10 +31,8 @@ func New(invoker *invoke.Invoker, gptscriptClient *gptscript.GPTScript) *Handler
 }
 
 func lastRunMatches(ctx context.Context, c kclient.Client, parent, current *v1.WorkflowStep) (bool, error) {
-	if parent.Status.LastRunName == "" {
-		if current.Status.HasRunsSet() {
-			return false, nil
-		}
+	if len(current.Status.RunNames) == 0 && current.Status.LastRunName == parent.Status.LastRunName {
+		// The step passed through the run of its parent, as a branch step that took an empty branch does.
 		return true, nil
 	}
 
@@ -53,6 +51,19 @@ func lastRunMatches(ctx context.Context, c kclient.Client, parent, current *v1.W
 	return firstRun.Spec.PreviousRunName == parent.Status.LastRunName, nil
 }
 
+// previousLastRunName returns the last run of the step that the given step runs after, if any.
+func previousLastRunName(req router.Request, step *v1.WorkflowStep) (string, error) {
+	if step.Spec.AfterWorkflowStepName == "" {
+		return "", nil
+	}
+
+	var previousStep v1.WorkflowStep
+	if err := req.Get(&previousStep, step.Namespace, step.Spec.AfterWorkflowStepName); err != nil {
+		return "", err
+	}
+	return previousStep.Status.LastRunName, nil
+}
+
 func deleteLastRuns(ctx context.Context, client kclient.Client, step *v1.WorkflowStep) error {
 	if step.Status.LastRunName != "" {
 		if err := client.Delete(ctx, &v1.Run{
@@ -78,6 +89,8 @@ func deleteLastRuns(ctx context.Context, client kclient.Client, step *v1.Workflo
 
 	step.Status.LastRunName = ""
 	step.Status.RunNames = nil
+	step.Status.StructuredOutput = ""
+	step.Status.OutputRetries = 0
 	return nil
 }
 
@@ -169,11 +182,6 @@ func (h *Handler) checkPreconditions(req router.Request, _ router.Response) (pro
 		}
 	}
 
-	if parent.Status.LastRunName == "" {
-		step.Status.State = types.WorkflowStateBlocked
-		return false, nil
-	}
-
 	if step.Status.State == "" {
 		step.Status.State = types.WorkflowStatePending
 	}
@@ -217,6 +225,13 @@ func GetStateFromSteps[T kclient.Object](ctx context.Context, client kclient.Cli
 			return "", "", types.WorkflowStateRunning, nil
 		}
 		if i == len(steps)-1 && step.Status.State == types.WorkflowStateComplete {
+			if step.Status.StructuredOutput != "" {
+				return step.Status.LastRunName, step.Status.StructuredOutput, types.WorkflowStateComplete, nil
+			}
+			if step.Status.LastRunName == "" {
+				// The step completed without running anything, as an empty branch at the start of a workflow does.
+				return "", "", types.WorkflowStateComplete, nil
+			}
 			var run v1.Run
 			if err := client.Get(ctx, router.Key(step.Namespace, step.Status.LastRunName), &run); err != nil {
 				return "", "", "", err
@@ -228,21 +243,30 @@ func GetStateFromSteps[T kclient.Object](ctx context.Context, client kclient.Cli
 	return "", "", types.WorkflowStateRunning, nil
 }
 
+// newChildStep returns a step that rootStep runs as part of itself. The step runs on the same thread as rootStep.
+func newChildStep(rootStep *v1.WorkflowStep, afterStepName string, step types.Step) *v1.WorkflowStep {
+	newStep := NewStep(rootStep.Namespace, rootStep.Spec.WorkflowExecutionName, afterStepName, rootStep.Spec.WorkflowGeneration, step)
+	newStep.Spec.ThreadName = rootStep.Spec.ThreadName
+	return newStep
+}
+
 var replaceRegexp = regexp.MustCompile(`[{},=]+`)
 
+func stepName(workflowExecutionName, stepID string) string {
+	newID := replaceRegexp.ReplaceAllString(stepID, "-")
+	stepName := name.SafeConcatName(system.WorkflowStepPrefix+strings.TrimPrefix(workflowExecutionName, system.WorkflowExecutionPrefix), newID)
+	stepName = strings.Trim(stepName, "-")
+	return strings.ReplaceAll(stepName, "--", "-")
+}
+
 func NewStep(namespace, workflowExecutionName, afterStepName string, generation int64, step types.Step) *v1.WorkflowStep {
 	if step.ID == "" {
 		panic("step ID is required")
 	}
 
-	newID := replaceRegexp.ReplaceAllString(step.ID, "-")
-	stepName := name.SafeConcatName(system.WorkflowStepPrefix+strings.TrimPrefix(workflowExecutionName, system.WorkflowExecutionPrefix), newID)
-	stepName = strings.Trim(stepName, "-")
-	stepName = strings.ReplaceAll(stepName, "--", "-")
-
 	return &v1.WorkflowStep{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      stepName,
+			Name:      stepName(workflowExecutionName, step.ID),
 			Namespace: namespace,
 		},
 		Spec: v1.WorkflowStepSpec{
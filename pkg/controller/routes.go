@@ -7,16 +7,19 @@ import (
 	"github.com/obot-platform/obot/pkg/controller/handlers/alias"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cleanup"
 	"github.com/obot-platform/obot/pkg/controller/handlers/cronjob"
+	"github.com/obot-platform/obot/pkg/controller/handlers/emailreceiver"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgefile"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgeset"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesource"
 	"github.com/obot-platform/obot/pkg/controller/handlers/knowledgesummary"
+	"github.com/obot-platform/obot/pkg/controller/handlers/notification"
 	"github.com/obot-platform/obot/pkg/controller/handlers/oauthapp"
 	"github.com/obot-platform/obot/pkg/controller/handlers/projects"
 	"github.com/obot-platform/obot/pkg/controller/handlers/retention"
 	"github.com/obot-platform/obot/pkg/controller/handlers/runs"
 	"github.com/obot-platform/obot/pkg/controller/handlers/runstates"
 	"github.com/obot-platform/obot/pkg/controller/handlers/slackreceiver"
+	"github.com/obot-platform/obot/pkg/controller/handlers/teamsmessage"
 	"github.com/obot-platform/obot/pkg/controller/handlers/threads"
 	"github.com/obot-platform/obot/pkg/controller/handlers/threadshare"
 	"github.com/obot-platform/obot/pkg/controller/handlers/toolinfo"
@@ -32,7 +35,7 @@ import (
 func (c *Controller) setupRoutes() error {
 	root := c.router
 
-	workflowExecution := workflowexecution.New(c.services.Invoker)
+	workflowExecution := workflowexecution.New(c.services.Invoker, c.services.GPTClient)
 	workflowStep := workflowstep.New(c.services.Invoker, c.services.GPTClient)
 	toolRef := toolreference.New(
 		c.services.GPTClient,
@@ -55,6 +58,9 @@ func (c *Controller) setupRoutes() error {
 	projects := projects.NewHandler()
 	runstates := runstates.NewHandler(c.services.GatewayClient)
 	userCleanup := cleanup.NewUserCleanup(c.services.GatewayClient)
+	emailReceivers := emailreceiver.New(c.services.EmailSender, c.services.EmailServerName)
+	notifications := notification.New(c.services.EmailSender, c.services.EmailServerName)
+	teamsMessages := teamsmessage.New(c.services.Invoker, c.services.GPTClient)
 
 	// Runs
 	root.Type(&v1.Run{}).FinalizeFunc(v1.RunFinalizer, runs.DeleteRunState)
@@ -73,6 +79,7 @@ func (c *Controller) setupRoutes() error {
 	root.Type(&v1.Thread{}).HandlerFunc(threads.CreateSharedWorkspace)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.CreateKnowledgeSet)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.WorkflowState)
+	root.Type(&v1.Thread{}).HandlerFunc(threads.AbortWithWorkflowExecution)
 	root.Type(&v1.Thread{}).HandlerFunc(knowledgesummary.Summarize)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.CleanupEphemeralThreads)
 	root.Type(&v1.Thread{}).HandlerFunc(threads.GenerateName)
@@ -90,15 +97,21 @@ func (c *Controller) setupRoutes() error {
 
 	// Workflows
 	root.Type(&v1.Workflow{}).HandlerFunc(workflow.EnsureIDs)
+	root.Type(&v1.Workflow{}).HandlerFunc(workflow.SetOnTaskCompletionSince)
 	root.Type(&v1.Workflow{}).HandlerFunc(threads.EnsureShared)
 	root.Type(&v1.Workflow{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.Workflow{}).FinalizeFunc(v1.WorkflowFinalizer, credentialCleanup.Remove)
 
 	// WorkflowExecutions
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.CleanupAttachments)
+	root.Type(&v1.WorkflowExecution{}).FinalizeFunc(v1.WorkflowExecutionFinalizer, workflowExecution.RemoveAttachments)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.Run)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.UpdateRun)
 	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.ReassignThread)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(workflowExecution.TriggerOnCompletion)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(emailReceivers.SendReply)
+	root.Type(&v1.WorkflowExecution{}).HandlerFunc(notifications.NotifyRun)
 
 	// Agents
 	root.Type(&v1.Agent{}).HandlerFunc(agents.CreateWorkspaceAndKnowledgeSet)
@@ -160,6 +173,13 @@ func (c *Controller) setupRoutes() error {
 	root.Type(&v1.Webhook{}).HandlerFunc(webHooks.SetSuccessRunTime)
 	root.Type(&v1.Webhook{}).HandlerFunc(generationed.UpdateObservedGeneration)
 	root.Type(&v1.Webhook{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.WebhookDelivery{}).HandlerFunc(webHooks.DeleteExpiredDeliveries)
+	root.Type(&v1.WebhookDelivery{}).HandlerFunc(cleanup.Cleanup)
+
+	// NotificationDeliveries
+	root.Type(&v1.NotificationDelivery{}).HandlerFunc(notifications.Deliver)
+	root.Type(&v1.NotificationDelivery{}).HandlerFunc(notifications.DeleteExpired)
+	root.Type(&v1.NotificationDelivery{}).HandlerFunc(cleanup.Cleanup)
 
 	// Cronjobs
 	root.Type(&v1.CronJob{}).HandlerFunc(cronJobs.SetSuccessRunTime)
@@ -187,8 +207,13 @@ func (c *Controller) setupRoutes() error {
 	// WorkflowSteps
 	root.Type(&v1.WorkflowStep{}).HandlerFunc(cleanup.Cleanup)
 	root.Type(&v1.WorkflowStep{}).HandlerFunc(handlers.GCOrphans)
+	root.Type(&v1.WorkflowStep{}).HandlerFunc(notifications.NotifyApproval)
 	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunInvoke)
 	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunLoop)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunBranch)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunParallel)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunApproval)
+	root.Type(&v1.WorkflowStep{}).Middleware(workflowStep.Preconditions).HandlerFunc(workflowStep.RunTask)
 
 	// AgentAuthorizations
 	root.Type(&v1.AgentAuthorization{}).HandlerFunc(cleanup.Cleanup)
@@ -203,6 +228,14 @@ func (c *Controller) setupRoutes() error {
 	// SlackTrigger
 	root.Type(&v1.SlackTrigger{}).HandlerFunc(cleanup.Cleanup)
 
+	// TeamsReceiver
+	root.Type(&v1.TeamsReceiver{}).HandlerFunc(cleanup.Cleanup)
+
+	// TeamsMessage
+	root.Type(&v1.TeamsMessage{}).HandlerFunc(cleanup.Cleanup)
+	root.Type(&v1.TeamsMessage{}).HandlerFunc(teamsMessages.DeleteExpired)
+	root.Type(&v1.TeamsMessage{}).HandlerFunc(teamsMessages.Reply)
+
 	// User Cleanup
 	root.Type(&v1.UserDelete{}).HandlerFunc(userCleanup.Cleanup)
 
@@ -0,0 +1,158 @@
+package emailtrigger
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"github.com/gptscript-ai/go-gptscript"
+	"github.com/obot-platform/obot/apiclient/types"
+)
+
+const defaultMaxAttachmentSize = 10 * 1024 * 1024
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+type attachmentInput struct {
+	Name        string `json:"name"`
+	ContentType string `json:"contentType,omitempty"`
+	Size        int    `json:"size"`
+	// Skipped is the reason the attachment was not saved to the workspace.
+	Skipped string `json:"skipped,omitempty"`
+}
+
+// saveAttachments saves the attachments that are allowed by the options to a new workspace under "files/", the same
+// as files uploaded to a thread, and returns the ID of the workspace along with the list of attachments for the input.
+// The workspace ID is empty if no attachments were saved.
+func (h *EmailHandler) saveAttachments(ctx context.Context, opts *types.EmailAttachmentOptions, attachments []Attachment) (_ string, _ []attachmentInput, retErr error) {
+	if len(attachments) == 0 {
+		return "", nil, nil
+	}
+	if opts == nil {
+		opts = new(types.EmailAttachmentOptions)
+	}
+
+	maxSize := opts.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxAttachmentSize
+	}
+
+	var (
+		workspaceID string
+		inputs      = make([]attachmentInput, 0, len(attachments))
+		names       = make(map[string]struct{}, len(attachments))
+	)
+
+	defer func() {
+		if retErr != nil && workspaceID != "" {
+			_ = h.gClient.DeleteWorkspace(context.Background(), workspaceID)
+		}
+	}()
+
+	for i, attachment := range attachments {
+		input := attachmentInput{
+			Name:        uniqueName(names, attachmentFilename(i, attachment)),
+			ContentType: attachment.ContentType,
+			Size:        len(attachment.Data),
+		}
+
+		if skipped, err := h.checkAttachment(ctx, opts, maxSize, attachment); err != nil {
+			return "", nil, err
+		} else if skipped != "" {
+			log.Infof("Skipping attachment %s: %s", input.Name, skipped)
+			input.Skipped = skipped
+			inputs = append(inputs, input)
+			continue
+		}
+
+		if workspaceID == "" {
+			var err error
+			workspaceID, err = h.gClient.CreateWorkspace(ctx, h.workspaceProvider)
+			if err != nil {
+				return "", nil, fmt.Errorf("failed to create workspace for attachments: %w", err)
+			}
+		}
+
+		if err := h.gClient.WriteFileInWorkspace(ctx, "files/"+input.Name, attachment.Data, gptscript.WriteFileInWorkspaceOptions{
+			WorkspaceID: workspaceID,
+		}); err != nil {
+			return "", nil, fmt.Errorf("failed to save attachment %q: %w", input.Name, err)
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return workspaceID, inputs, nil
+}
+
+// checkAttachment returns the reason the attachment should be skipped, or an empty string if it should be saved.
+func (h *EmailHandler) checkAttachment(ctx context.Context, opts *types.EmailAttachmentOptions, maxSize int64, attachment Attachment) (string, error) {
+	if opts.Disabled {
+		return "attachments are disabled", nil
+	}
+	if int64(len(attachment.Data)) > maxSize {
+		return fmt.Sprintf("larger than the maximum size of %d bytes", maxSize), nil
+	}
+	if !typeAllowed(attachment.ContentType, opts.AllowedTypes) {
+		return fmt.Sprintf("content type %q is not allowed", attachment.ContentType), nil
+	}
+
+	if fromProvider, err := h.dispatcher.ScanFile(ctx, attachment.Data); err != nil {
+		if fromProvider {
+			return fmt.Sprintf("file is infected with virus: %v", err), nil
+		}
+		return "", fmt.Errorf("failed to scan attachment: %w", err)
+	}
+
+	return "", nil
+}
+
+func typeAllowed(contentType string, allowedTypes []string) bool {
+	if len(allowedTypes) == 0 {
+		return true
+	}
+
+	for _, allowedType := range allowedTypes {
+		if strings.EqualFold(allowedType, contentType) {
+			return true
+		}
+		if matched, _ := path.Match(strings.ToLower(allowedType), strings.ToLower(contentType)); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func attachmentFilename(i int, attachment Attachment) string {
+	name := path.Base(strings.ReplaceAll(attachment.Filename, "\\", "/"))
+	if name != "." && name != "/" && name != ".." {
+		return name
+	}
+
+	name = fmt.Sprintf("attachment-%d", i+1)
+	if exts, _ := mime.ExtensionsByType(attachment.ContentType); len(exts) > 0 {
+		name += exts[0]
+	}
+	return name
+}
+
+// uniqueName returns the name, with a number added before the extension if it has already been used.
+func uniqueName(names map[string]struct{}, name string) string {
+	result := name
+	ext := path.Ext(name)
+	for i := 1; ; i++ {
+		if _, ok := names[result]; !ok {
+			break
+		}
+		result = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), i, ext)
+	}
+	names[result] = struct{}{}
+	return result
+}
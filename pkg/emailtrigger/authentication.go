@@ -0,0 +1,44 @@
+package emailtrigger
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+// Authentication is the result of checking that an email was sent by the domain of its From address.
+type Authentication struct {
+	// From is the address of the From header, which is what DMARC authenticates.
+	From string `json:"from"`
+	// SPF is the SPF result for the envelope sender, such as "pass", "fail", or "none".
+	SPF string `json:"spf"`
+	// DKIM is "pass" if any DKIM signature is valid, "fail" if none are, or "none" if the email is not signed.
+	DKIM string `json:"dkim"`
+	// DMARC is "pass" if SPF or DKIM passes for a domain aligned with the domain of the From address.
+	DMARC string `json:"dmarc"`
+	// DMARCPolicy is the policy published by the domain of the From address, if any.
+	DMARCPolicy string `json:"dmarcPolicy,omitempty"`
+}
+
+// authenticate applies the sender authentication policy of the receiver. It returns the address the allowed
+// senders are matched against, the authentication results to add to the input, and false if the email should be dropped.
+func authenticate(email Email, receiver v1.EmailReceiver) (string, *Authentication, bool) {
+	policy := receiver.Spec.SenderAuthentication
+	if policy == "" || policy == types.SenderAuthenticationAllow {
+		return email.From, nil, true
+	}
+
+	var result *Authentication
+	if email.Authenticate != nil {
+		result = email.Authenticate()
+	}
+
+	if policy == types.SenderAuthenticationReject {
+		if result == nil || result.DMARC != "pass" {
+			return "", result, false
+		}
+		// The From header is what is authenticated, not the envelope sender.
+		return result.From, result, true
+	}
+
+	return email.From, result, true
+}
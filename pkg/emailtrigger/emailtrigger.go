@@ -8,9 +8,12 @@ import (
 	"path"
 	"strings"
 
+	"github.com/gptscript-ai/go-gptscript"
 	"github.com/obot-platform/nah/pkg/router"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
+	"github.com/obot-platform/obot/pkg/invoke"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
 	"github.com/obot-platform/obot/pkg/system"
 	apierror "k8s.io/apimachinery/pkg/api/errors"
@@ -21,19 +24,61 @@ import (
 var log = logger.Package()
 
 type EmailHandler struct {
-	c        kclient.Client
-	hostname string
+	c                 kclient.WithWatch
+	invoker           *invoke.Invoker
+	gClient           *gptscript.GPTScript
+	dispatcher        *dispatcher.Dispatcher
+	workspaceProvider string
+	hostname          string
 }
 
-func EmailTrigger(c kclient.Client, hostname string) *EmailHandler {
+func EmailTrigger(c kclient.WithWatch, invoker *invoke.Invoker, gClient *gptscript.GPTScript, dispatcher *dispatcher.Dispatcher, workspaceProvider, hostname string) *EmailHandler {
 	return &EmailHandler{
-		c:        c,
-		hostname: hostname,
+		c:                 c,
+		invoker:           invoker,
+		gClient:           gClient,
+		dispatcher:        dispatcher,
+		workspaceProvider: workspaceProvider,
+		hostname:          hostname,
 	}
 }
 
-func (h *EmailHandler) Handler(ctx context.Context, from string, to []string, subject string, data []byte) error {
-	for _, to := range to {
+type Email struct {
+	// From is the sender of the envelope, which is the return path for bounces and not always the author.
+	From string
+	To   []string
+	// ReplyTo is the address that replies are sent to, from the Reply-To or From header. See ReplyAddress.
+	ReplyTo string
+	Subject string
+	Body    string
+	// MessageID is the Message-ID header of the email and References are the message IDs from its In-Reply-To
+	// and References headers.
+	MessageID   string
+	References  []string
+	Attachments []Attachment
+	// Authenticate checks the SPF, DKIM, and DMARC of the email. It is nil if the email cannot be checked.
+	Authenticate func() *Authentication
+}
+
+type emailInput struct {
+	Type        string            `json:"type"`
+	From        string            `json:"from"`
+	To          string            `json:"to"`
+	Subject     string            `json:"subject"`
+	Body        string            `json:"body"`
+	Attachments []attachmentInput `json:"attachments,omitempty"`
+	// History is the emails before this one in the same thread, when a reply continues a task run.
+	History        []emailHistory  `json:"history,omitempty"`
+	Authentication *Authentication `json:"authentication,omitempty"`
+}
+
+type emailHistory struct {
+	From string `json:"from"`
+	Body string `json:"body"`
+}
+
+func (h *EmailHandler) Handler(ctx context.Context, email Email) error {
+	for _, to := range email.To {
 		toAddr, err := mail.ParseAddress(to)
 		if err != nil {
 			return fmt.Errorf("parse to address: %w", err)
@@ -68,12 +113,18 @@ func (h *EmailHandler) Handler(ctx context.Context, from string, to []string, su
 			return fmt.Errorf("get email receiver: %w", err)
 		}
 
-		if !matches(from, emailReceiver) {
+		sender, authentication, ok := authenticate(email, emailReceiver)
+		if !ok {
+			log.Infof("Skipping mail for %s: sender not authenticated: %+v", toAddr.Address, authentication)
+			continue
+		}
+
+		if !matches(sender, emailReceiver) {
 			log.Infof("Skipping mail for %s: sender not allowed", toAddr.Address)
 			continue
 		}
 
-		if err = h.dispatchEmail(ctx, emailReceiver, string(data), from, to, subject); err != nil {
+		if err = h.dispatchEmail(ctx, emailReceiver, email, authentication, to, toAddr.Address); err != nil {
 			return fmt.Errorf("dispatch email: %w", err)
 		}
 	}
@@ -81,29 +132,68 @@ func (h *EmailHandler) Handler(ctx context.Context, from string, to []string, su
 	return nil
 }
 
-func (h *EmailHandler) dispatchEmail(ctx context.Context, email v1.EmailReceiver, body string, from, to, subject string) error {
-	var input struct {
-		Type    string `json:"type"`
-		From    string `json:"from"`
-		To      string `json:"to"`
-		Subject string `json:"subject"`
-		Body    string `json:"body"`
+func (h *EmailHandler) dispatchEmail(ctx context.Context, receiver v1.EmailReceiver, email Email, authentication *Authentication, to, toAddress string) (retErr error) {
+	input := emailInput{
+		Type:           "email",
+		From:           email.From,
+		To:             to,
+		Subject:        email.Subject,
+		Body:           email.Body,
+		Authentication: authentication,
 	}
 
-	input.Type = "email"
-	input.From = from
-	input.To = to
-	input.Subject = subject
-	input.Body = body
+	var workflow v1.Workflow
+	if err := h.c.Get(ctx, router.Key(receiver.Namespace, receiver.Spec.WorkflowName), &workflow); err != nil {
+		return err
+	}
+
+	var previous *v1.WorkflowExecution
+	if receiver.Spec.Reply {
+		var err error
+		previous, err = h.repliedExecution(ctx, receiver, email.References)
+		if err != nil {
+			return err
+		}
+	}
+
+	workspaceID, attachmentInputs, err := h.saveAttachments(ctx, receiver.Spec.Attachments, email.Attachments)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil && workspaceID != "" {
+			_ = h.gClient.DeleteWorkspace(context.Background(), workspaceID)
+		}
+	}()
+
+	input.Attachments = attachmentInputs
+
+	var emailMessage *v1.EmailMessage
+	if receiver.Spec.Reply && email.MessageID != "" {
+		replyTo := email.ReplyTo
+		if replyTo == "" {
+			replyTo = email.From
+		}
+		emailMessage = &v1.EmailMessage{
+			MessageID:  email.MessageID,
+			From:       toAddress,
+			To:         replyTo,
+			Subject:    email.Subject,
+			References: email.References,
+		}
+	}
+
+	if previous != nil {
+		input.History = history(previous, toAddress)
+	}
 
 	inputJSON, err := json.Marshal(input)
 	if err != nil {
 		return fmt.Errorf("marshal input: %w", err)
 	}
 
-	var workflow v1.Workflow
-	if err := h.c.Get(ctx, router.Key(email.Namespace, email.Spec.WorkflowName), &workflow); err != nil {
-		return err
+	if previous != nil {
+		return h.continueExecution(ctx, &workflow, previous, string(inputJSON), workspaceID, emailMessage)
 	}
 
 	return h.c.Create(ctx, &v1.WorkflowExecution{
@@ -112,10 +202,12 @@ func (h *EmailHandler) dispatchEmail(ctx context.Context, email v1.EmailReceiver
 			Namespace:    workflow.Namespace,
 		},
 		Spec: v1.WorkflowExecutionSpec{
-			WorkflowName:      workflow.Name,
-			EmailReceiverName: email.Name,
-			ThreadName:        workflow.Spec.ThreadName,
-			Input:             string(inputJSON),
+			WorkflowName:           workflow.Name,
+			EmailReceiverName:      receiver.Name,
+			ThreadName:             workflow.Spec.ThreadName,
+			Input:                  string(inputJSON),
+			AttachmentsWorkspaceID: workspaceID,
+			EmailMessage:           emailMessage,
 		},
 	})
 }
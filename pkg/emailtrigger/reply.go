@@ -0,0 +1,112 @@
+package emailtrigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/pkg/invoke"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	apierror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReplyMessageID returns the message ID of the reply to the email of the current generation of the execution. The
+// name of the execution is part of the ID so that replies to the reply can continue the execution.
+func ReplyMessageID(we *v1.WorkflowExecution, hostname string) string {
+	return fmt.Sprintf("<%s.%d@%s>", we.Name, we.Spec.WorkflowGeneration, hostname)
+}
+
+// ParseMessageIDs returns the message IDs in the values of Message-ID, In-Reply-To, or References headers.
+func ParseMessageIDs(headers ...string) []string {
+	var ids []string
+	for _, header := range headers {
+		for _, id := range strings.Fields(header) {
+			if strings.HasPrefix(id, "<") && strings.HasSuffix(id, ">") && !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// ReplyAddress returns the address that replies to an email are sent to: the first address of its Reply-To header,
+// or of its From header if it has no Reply-To. Mailing lists and bulk senders use a return path for bounces as the
+// sender of the envelope, so that is only used if neither header has an address.
+func ReplyAddress(replyTo, from, envelopeFrom string) string {
+	for _, header := range []string{replyTo, from} {
+		if addresses, err := mail.ParseAddressList(header); err == nil && len(addresses) > 0 {
+			return addresses[0].Address
+		}
+	}
+	return envelopeFrom
+}
+
+// repliedExecution returns the execution of the receiver that sent one of the referenced emails as a reply, or nil
+// if there is none or it is still running.
+func (h *EmailHandler) repliedExecution(ctx context.Context, receiver v1.EmailReceiver, references []string) (*v1.WorkflowExecution, error) {
+	for _, id := range slices.Backward(references) {
+		local, host, ok := strings.Cut(strings.Trim(id, "<>"), "@")
+		if !ok || host != h.hostname {
+			continue
+		}
+		i := strings.LastIndex(local, ".")
+		if i < 0 {
+			continue
+		}
+
+		var we v1.WorkflowExecution
+		if err := h.c.Get(ctx, router.Key(receiver.Namespace, local[:i]), &we); apierror.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if we.Spec.EmailReceiverName != receiver.Name {
+			continue
+		}
+		if !we.Status.State.IsTerminal() {
+			log.Infof("Starting a new run for reply to %s: run %s is still running", id, we.Name)
+			return nil, nil
+		}
+		return &we, nil
+	}
+
+	return nil, nil
+}
+
+// history returns the emails of the thread up to and including the reply sent by the execution.
+func history(we *v1.WorkflowExecution, receiverAddress string) []emailHistory {
+	var previous emailInput
+	if err := json.Unmarshal([]byte(we.Spec.Input), &previous); err != nil {
+		return nil
+	}
+
+	return append(previous.History,
+		emailHistory{From: previous.From, Body: previous.Body},
+		emailHistory{From: receiverAddress, Body: we.Status.Output},
+	)
+}
+
+// continueExecution runs the execution again, in the same thread, with the input of a reply to it.
+func (h *EmailHandler) continueExecution(ctx context.Context, workflow *v1.Workflow, we *v1.WorkflowExecution, input, workspaceID string, emailMessage *v1.EmailMessage) error {
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		if err := h.c.Get(ctx, kclient.ObjectKeyFromObject(we), we); err != nil {
+			return err
+		}
+		we.Spec.AttachmentsWorkspaceID = workspaceID
+		we.Spec.EmailMessage = emailMessage
+		return h.c.Update(ctx, we)
+	}); err != nil {
+		return err
+	}
+
+	_, err := h.invoker.Workflow(ctx, h.c, workflow, input, invoke.WorkflowOptions{
+		WorkflowExecutionName: we.Name,
+	})
+	return err
+}
@@ -0,0 +1,61 @@
+package emailtrigger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplyAddress(t *testing.T) {
+	tests := []struct {
+		name         string
+		replyTo      string
+		from         string
+		envelopeFrom string
+		want         string
+	}{
+		{
+			name:         "from header",
+			from:         "Alice <alice@example.com>",
+			envelopeFrom: "alice@example.com",
+			want:         "alice@example.com",
+		},
+		{
+			name:         "reply-to header",
+			replyTo:      "Support <support@example.com>",
+			from:         "Alice <alice@example.com>",
+			envelopeFrom: "alice@example.com",
+			want:         "support@example.com",
+		},
+		{
+			name:         "bounce address of a mailing list",
+			from:         "Alice <alice@example.com>",
+			envelopeFrom: "list-bounces+alice=example.com@lists.example.org",
+			want:         "alice@example.com",
+		},
+		{
+			name:         "more than one address",
+			replyTo:      "alice@example.com, bob@example.com",
+			envelopeFrom: "bounce@example.net",
+			want:         "alice@example.com",
+		},
+		{
+			name:         "invalid reply-to header",
+			replyTo:      "not an address",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@example.net",
+			want:         "alice@example.com",
+		},
+		{
+			name:         "no headers",
+			envelopeFrom: "alice@example.com",
+			want:         "alice@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ReplyAddress(tt.replyTo, tt.from, tt.envelopeFrom))
+		})
+	}
+}
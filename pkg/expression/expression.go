@@ -0,0 +1,223 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Evaluate evaluates a simple expression. The expression is either a single operand or two operands joined by
+// one of ==, !=, contains, or matches. Operands are quoted strings or references that are looked up with resolve.
+// Comparisons evaluate to "true" or "false".
+func Evaluate(expr string, resolve func(string) (string, error)) (string, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return "", err
+	}
+
+	switch len(tokens) {
+	case 1:
+		if tokens[0].kind == tokenOperator {
+			return "", fmt.Errorf("invalid expression %q: expected an operand before %s", expr, tokens[0].value)
+		}
+		return operandValue(tokens[0], resolve)
+	case 3:
+	default:
+		return "", fmt.Errorf("invalid expression %q: expected an operand or a comparison", expr)
+	}
+
+	if tokens[0].kind == tokenOperator || tokens[2].kind == tokenOperator {
+		return "", fmt.Errorf("invalid expression %q: expected operands on both sides of the comparison", expr)
+	}
+	if tokens[1].kind == tokenString {
+		return "", fmt.Errorf("invalid expression %q: expected an operator, found the string %q", expr, tokens[1].value)
+	}
+
+	left, err := operandValue(tokens[0], resolve)
+	if err != nil {
+		return "", err
+	}
+	right, err := operandValue(tokens[2], resolve)
+	if err != nil {
+		return "", err
+	}
+	left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+
+	var result bool
+	switch tokens[1].value {
+	case "==":
+		result = left == right
+	case "!=":
+		result = left != right
+	case "contains":
+		result = strings.Contains(left, right)
+	case "matches":
+		re, err := regexp.Compile(right)
+		if err != nil {
+			return "", fmt.Errorf("invalid regular expression %q: %w", right, err)
+		}
+		result = re.MatchString(left)
+	default:
+		return "", fmt.Errorf("invalid operator %q in expression %q", tokens[1].value, expr)
+	}
+
+	return strconv.FormatBool(result), nil
+}
+
+// IsTrue returns whether the value of an expression is true. Values are true unless they are empty, false, no, 0, null,
+// or none.
+func IsTrue(value string) bool {
+	switch normalize(value) {
+	case "", "false", "no", "0", "null", "none":
+		return false
+	}
+	return true
+}
+
+// ParseBool parses the answer of a model to a true or false question. Unlike IsTrue, it returns an error for anything
+// other than true or false, so that an answer such as "Not true" is not mistaken for true.
+func ParseBool(value string) (bool, error) {
+	switch normalize(value) {
+	case "true", "yes":
+		return true, nil
+	case "false", "no":
+		return false, nil
+	}
+	return false, fmt.Errorf("expected true or false, got %q", value)
+}
+
+func normalize(value string) string {
+	return strings.ToLower(strings.Trim(strings.TrimSpace(value), ".!\"'`*"))
+}
+
+type tokenKind int
+
+const (
+	// tokenWord is a reference or one of the word operators, contains and matches.
+	tokenWord tokenKind = iota
+	// tokenString is a quoted string.
+	tokenString
+	// tokenOperator is == or !=.
+	tokenOperator
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+// tokenize splits an expression into quoted strings, the == and != operators, and words. Operators don't need to be
+// separated from their operands by spaces, and strings can contain spaces, operators, and escaped quotes.
+func tokenize(expr string) (result []token, _ error) {
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '"' || c == '\'':
+			value, n, err := lexString(expr[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid expression %q: %w", expr, err)
+			}
+			result = append(result, token{kind: tokenString, value: value})
+			i += n
+		case c == '=' || c == '!':
+			if i+1 >= len(expr) || expr[i+1] != '=' {
+				return nil, fmt.Errorf("invalid expression %q: unexpected %q at position %d, expected == or !=", expr, c, i)
+			}
+			result = append(result, token{kind: tokenOperator, value: expr[i : i+2]})
+			i += 2
+		default:
+			end := strings.IndexFunc(expr[i:], func(r rune) bool {
+				return unicode.IsSpace(r) || r == '"' || r == '\'' || r == '=' || r == '!'
+			})
+			if end < 0 {
+				end = len(expr) - i
+			}
+			result = append(result, token{kind: tokenWord, value: expr[i : i+end]})
+			i += end
+		}
+	}
+	return result, nil
+}
+
+// lexString reads the quoted string at the start of s, and returns its value and the number of bytes it takes up. A
+// backslash escapes the next character.
+func lexString(s string) (string, int, error) {
+	var (
+		quote = s[0]
+		b     strings.Builder
+	)
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 >= len(s) {
+				return "", 0, fmt.Errorf("unterminated string %s", s)
+			}
+			i++
+			b.WriteByte(s[i])
+		case quote:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string %s", s)
+}
+
+func operandValue(t token, resolve func(string) (string, error)) (string, error) {
+	if t.kind == tokenString {
+		return t.value, nil
+	}
+	return resolve(t.value)
+}
+
+// LookupPath returns the value at the dot separated path in the JSON document. An empty path returns the value
+// unchanged, and a path into an empty value or to a missing key or index returns an empty string. It is an error if the
+// value is not JSON or the path goes through a value that is not an object or array.
+func LookupPath(value, path string) (string, error) {
+	if path == "" {
+		return value, nil
+	}
+	if strings.TrimSpace(value) == "" {
+		return "", nil
+	}
+
+	var data any
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return "", fmt.Errorf("cannot look up %q: value is not JSON: %w", path, err)
+	}
+
+	for _, key := range strings.Split(path, ".") {
+		switch v := data.(type) {
+		case map[string]any:
+			data = v[key]
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil {
+				return "", fmt.Errorf("cannot look up %q: %q is not an index of an array", path, key)
+			}
+			if i < 0 || i >= len(v) {
+				return "", nil
+			}
+			data = v[i]
+		case nil:
+			return "", nil
+		default:
+			return "", fmt.Errorf("cannot look up %q: %q is not in an object or array", path, key)
+		}
+	}
+
+	switch v := data.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		result, err := json.Marshal(v)
+		return string(result), err
+	}
+}
@@ -0,0 +1,179 @@
+package expression
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	refs := map[string]string{
+		"input.action":  "opened",
+		"input.title":   "Fix the build",
+		"input.count":   " 3 ",
+		"input.empty":   "",
+		"headers.x-env": "prod",
+	}
+	resolve := func(ref string) (string, error) {
+		value, ok := refs[ref]
+		if !ok {
+			return "", fmt.Errorf("unknown reference %q", ref)
+		}
+		return value, nil
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    string
+		wantErr bool
+	}{
+		{name: "reference", expr: "input.action", want: "opened"},
+		{name: "string", expr: `"hello world"`, want: "hello world"},
+		{name: "single quoted string", expr: `'hello world'`, want: "hello world"},
+		{name: "escaped quote", expr: `"say \"hi\""`, want: `say "hi"`},
+		{name: "equal", expr: `input.action == "opened"`, want: "true"},
+		{name: "equal without spaces", expr: `input.action=="opened"`, want: "true"},
+		{name: "not equal", expr: `input.action != "opened"`, want: "false"},
+		{name: "not equal without spaces", expr: `input.action!='closed'`, want: "true"},
+		{name: "string with spaces", expr: `input.title == "Fix the build"`, want: "true"},
+		{name: "string with operator", expr: `"a == b" contains "=="`, want: "true"},
+		{name: "operands are trimmed", expr: `input.count == "3"`, want: "true"},
+		{name: "contains", expr: `input.title contains "build"`, want: "true"},
+		{name: "does not contain", expr: `input.title contains "test"`, want: "false"},
+		{name: "matches", expr: `input.title matches "^Fix"`, want: "true"},
+		{name: "does not match", expr: `input.title matches "^fix$"`, want: "false"},
+		{name: "two references", expr: "headers.x-env == input.action", want: "false"},
+		{name: "empty string", expr: `input.empty == ""`, want: "true"},
+		{name: "extra whitespace", expr: "  input.action\t==\t'opened'  ", want: "true"},
+		{name: "empty", expr: "", wantErr: true},
+		{name: "only operator", expr: "==", wantErr: true},
+		{name: "missing operand", expr: `input.action ==`, wantErr: true},
+		{name: "operator as operand", expr: `input.action == !=`, wantErr: true},
+		{name: "unknown operator", expr: `input.action is "opened"`, wantErr: true},
+		{name: "string as operator", expr: `input.action "is" "opened"`, wantErr: true},
+		{name: "single equals", expr: `input.action = "opened"`, wantErr: true},
+		{name: "bang", expr: `!input.action`, wantErr: true},
+		{name: "unterminated string", expr: `input.action == "opened`, wantErr: true},
+		{name: "trailing backslash", expr: `input.action == "opened\`, wantErr: true},
+		{name: "too many tokens", expr: `input.action == "opened" == "true"`, wantErr: true},
+		{name: "unknown reference", expr: `input.missing == "x"`, wantErr: true},
+		{name: "invalid regular expression", expr: `input.title matches "("`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(tt.expr, resolve)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestIsTrue(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{value: "", want: false},
+		{value: "  ", want: false},
+		{value: "false", want: false},
+		{value: "False.", want: false},
+		{value: "no", want: false},
+		{value: "0", want: false},
+		{value: "null", want: false},
+		{value: "none", want: false},
+		{value: "true", want: true},
+		{value: "yes", want: true},
+		{value: "1", want: true},
+		{value: "opened", want: true},
+		{value: "false positive", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			require.Equal(t, tt.want, IsTrue(tt.value))
+		})
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: "true", want: true},
+		{value: " True.\n", want: true},
+		{value: "**true**", want: true},
+		{value: "yes", want: true},
+		{value: "false", want: false},
+		{value: "FALSE", want: false},
+		{value: "No.", want: false},
+		{value: "Not true", wantErr: true},
+		{value: "true or false", wantErr: true},
+		{value: "I don't know", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseBool(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLookupPath(t *testing.T) {
+	const doc = `{"action":"opened","number":42,"draft":false,"labels":[{"name":"bug"},{"name":"ui"}],"user":{"login":"octocat"},"milestone":null}`
+
+	tests := []struct {
+		name    string
+		value   string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty path", value: "not json", path: "", want: "not json"},
+		{name: "string", value: doc, path: "action", want: "opened"},
+		{name: "number", value: doc, path: "number", want: "42"},
+		{name: "boolean", value: doc, path: "draft", want: "false"},
+		{name: "nested", value: doc, path: "user.login", want: "octocat"},
+		{name: "object", value: doc, path: "user", want: `{"login":"octocat"}`},
+		{name: "array index", value: doc, path: "labels.1.name", want: "ui"},
+		{name: "null", value: doc, path: "milestone", want: ""},
+		{name: "into null", value: doc, path: "milestone.title", want: ""},
+		{name: "missing key", value: doc, path: "assignee", want: ""},
+		{name: "missing nested key", value: doc, path: "assignee.login", want: ""},
+		{name: "index out of range", value: doc, path: "labels.5.name", want: ""},
+		{name: "negative index", value: doc, path: "labels.-1", want: ""},
+		{name: "empty value", value: "", path: "action", want: ""},
+		{name: "not json", value: "opened", path: "action", wantErr: true},
+		{name: "invalid json", value: `{"action":`, path: "action", wantErr: true},
+		{name: "key of array", value: doc, path: "labels.name", wantErr: true},
+		{name: "into string", value: doc, path: "action.length", wantErr: true},
+		{name: "into number", value: doc, path: "number.value", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupPath(tt.value, tt.path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+)
+
+func (c *Client) Budgets(ctx context.Context) ([]types.Budget, error) {
+	var budgets []types.Budget
+	return budgets, c.db.WithContext(ctx).Order("id").Find(&budgets).Error
+}
+
+func (c *Client) BudgetByID(ctx context.Context, id string) (*types.Budget, error) {
+	budget := new(types.Budget)
+	return budget, c.db.WithContext(ctx).Where("id = ?", id).First(budget).Error
+}
+
+func (c *Client) CreateBudget(ctx context.Context, budget *types.Budget) error {
+	return c.db.WithContext(ctx).Create(budget).Error
+}
+
+func (c *Client) UpdateBudget(ctx context.Context, budget *types.Budget) error {
+	return c.db.WithContext(ctx).Save(budget).Error
+}
+
+func (c *Client) DeleteBudget(ctx context.Context, id string) error {
+	return c.db.WithContext(ctx).Where("id = ?", id).Delete(new(types.Budget)).Error
+}
+
+// BudgetsFor returns the budgets that the usage of the project, agent, and user counts towards.
+func (c *Client) BudgetsFor(ctx context.Context, projectID, agentID, userID string) ([]types.Budget, error) {
+	budgets, err := c.Budgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applicable := budgets[:0]
+	for _, budget := range budgets {
+		if budget.Applies(projectID, agentID, userID) {
+			applicable = append(applicable, budget)
+		}
+	}
+	return applicable, nil
+}
+
+// RemainingBudget returns the usage of the budget in its window that contains now, and what is left of it.
+func (c *Client) RemainingBudget(ctx context.Context, budget types.Budget, now time.Time) (*types.RemainingBudget, error) {
+	start, end := budget.CurrentWindow(now)
+
+	db := c.db.WithContext(ctx).Model(new(types.RunTokenActivity)).
+		Select("COALESCE(SUM(total_tokens), 0) as total_tokens, COALESCE(SUM(cost), 0) as cost").
+		Where("created_at >= ? AND created_at < ?", start, end)
+	if budget.ProjectID != "" {
+		db = db.Where("project_id = ?", budget.ProjectID)
+	}
+	if budget.AgentID != "" {
+		db = db.Where("agent_id = ?", budget.AgentID)
+	}
+	if len(budget.UserIDs) > 0 {
+		db = db.Where("user_id IN ?", budget.UserIDs)
+	}
+	if budget.Model != "" {
+		db = db.Where("model = ?", budget.Model)
+	}
+
+	var usage types.RunTokenActivity
+	if err := db.Scan(&usage).Error; err != nil {
+		return nil, err
+	}
+
+	r := &types.RemainingBudget{
+		Start:           start,
+		End:             end,
+		UsedTokens:      usage.TotalTokens,
+		UsedCost:        usage.Cost,
+		RemainingTokens: budget.Hard.Tokens - usage.TotalTokens,
+		RemainingCost:   budget.Hard.Cost - usage.Cost,
+		UnlimitedTokens: budget.Hard.Tokens <= 0,
+		UnlimitedCost:   budget.Hard.Cost <= 0,
+		State:           types2.BudgetStateOK,
+	}
+	if r.UnlimitedTokens {
+		r.RemainingTokens = 0
+	}
+	if r.UnlimitedCost {
+		r.RemainingCost = 0
+	}
+
+	switch {
+	case reached(usage, budget.Hard):
+		r.State = types2.BudgetStateExceeded
+	case reached(usage, budget.Soft):
+		r.State = types2.BudgetStateWarning
+	}
+
+	return r, nil
+}
+
+// reached returns whether the usage has reached either part of the limit that is set.
+func reached(usage types.RunTokenActivity, limit types2.BudgetLimit) bool {
+	return limit.Tokens > 0 && usage.TotalTokens >= limit.Tokens || limit.Cost > 0 && usage.Cost >= limit.Cost
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CachedResponse returns the cached response with the hash, or nil if there is none that has not expired.
+func (c *Client) CachedResponse(ctx context.Context, hash string) (*types.LLMResponseCacheEntry, error) {
+	entry := new(types.LLMResponseCacheEntry)
+	if err := c.db.WithContext(ctx).Where("hash = ? AND expires_at > ?", hash, time.Now()).First(entry).Error; errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (c *Client) CacheResponse(ctx context.Context, entry *types.LLMResponseCacheEntry) error {
+	return c.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(entry).Error
+}
+
+func (c *Client) DeleteExpiredCachedResponses(ctx context.Context) error {
+	return c.db.WithContext(ctx).Where("expires_at <= ?", time.Now()).Delete(new(types.LLMResponseCacheEntry)).Error
+}
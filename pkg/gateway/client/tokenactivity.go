@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	types2 "github.com/obot-platform/obot/apiclient/types"
@@ -85,14 +86,42 @@ func (c *Client) RemainingTokenUsageForUser(ctx context.Context, userID string,
 }
 
 func (c *Client) tokenUsageByUser(ctx context.Context, userID string, start, end time.Time) ([]types.RunTokenActivity, error) {
+	return c.tokenUsageBy(ctx, "user_id", userID, start, end)
+}
+
+// TokenUsageBy sums the token usage and its cost by user, project, task, or model.
+func (c *Client) TokenUsageBy(ctx context.Context, groupBy types2.TokenUsageGroupBy, start, end time.Time) ([]types.RunTokenActivity, error) {
+	switch groupBy {
+	case types2.TokenUsageGroupByUser, "":
+		return c.tokenUsageBy(ctx, "user_id", "", start, end)
+	case types2.TokenUsageGroupByProject:
+		return c.tokenUsageBy(ctx, "project_id", "", start, end)
+	case types2.TokenUsageGroupByTask:
+		return c.tokenUsageBy(ctx, "workflow_id", "", start, end)
+	case types2.TokenUsageGroupByModel:
+		return c.tokenUsageBy(ctx, "model", "", start, end)
+	}
+	return nil, types2.NewErrBadRequest("invalid groupBy %q: must be user, project, task, or model", groupBy)
+}
+
+// tokenUsageBy sums the token usage by the column. If value is set, then only the usage with that value is summed.
+// Usage without a value for the column, such as the usage of chats for the task column, is summed in a group with an
+// empty value, except for users.
+func (c *Client) tokenUsageBy(ctx context.Context, column, value string, start, end time.Time) ([]types.RunTokenActivity, error) {
+	group := column
+	if column != "user_id" {
+		group = fmt.Sprintf("COALESCE(%s, '')", column)
+	}
+
 	var activities []types.RunTokenActivity
 	db := c.db.WithContext(ctx).Model(new(types.RunTokenActivity)).
-		Select("user_id, SUM(prompt_tokens) as prompt_tokens, SUM(completion_tokens) as completion_tokens, SUM(total_tokens) as total_tokens").
+		// The cost and cached prompt tokens are not set for usage that was recorded before they were added.
+		Select(group+" as "+column+", SUM(prompt_tokens) as prompt_tokens, COALESCE(SUM(cached_prompt_tokens), 0) as cached_prompt_tokens, SUM(completion_tokens) as completion_tokens, SUM(total_tokens) as total_tokens, COALESCE(SUM(cost), 0) as cost").
 		Where("created_at >= ? AND created_at < ?", start, end)
-	if userID != "" {
-		db = db.Where("user_id = ?", userID)
-	} else {
+	if value != "" {
+		db = db.Where(column+" = ?", value)
+	} else if column == "user_id" {
 		db = db.Where("user_id IS NOT NULL")
 	}
-	return activities, db.Group("user_id").Scan(&activities).Error
+	return activities, db.Group(group).Scan(&activities).Error
 }
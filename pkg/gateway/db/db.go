@@ -65,6 +65,8 @@ func (db *DB) AutoMigrate() (err error) {
 		types.RunState{},
 		types.FileScannerConfig{},
 		types.RunTokenActivity{},
+		types.Budget{},
+		types.LLMResponseCacheEntry{},
 	)
 }
 
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+	"github.com/obot-platform/obot/pkg/alias"
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/jwt"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	"gorm.io/gorm"
+)
+
+// budgetWarningHeader is set on LLM proxy responses for each budget that has reached its soft limit.
+const budgetWarningHeader = "X-Obot-Budget-Warning"
+
+func (s *Server) listBudgets(apiContext api.Context) error {
+	budgets, err := s.client.Budgets(apiContext.Context())
+	if err != nil {
+		return err
+	}
+
+	items := make([]types2.Budget, 0, len(budgets))
+	for _, budget := range budgets {
+		items = append(items, types.ConvertBudget(budget))
+	}
+
+	return apiContext.Write(types2.BudgetList{Items: items})
+}
+
+func (s *Server) getBudget(apiContext api.Context) error {
+	budget, err := s.budgetByID(apiContext)
+	if err != nil {
+		return err
+	}
+
+	return apiContext.Write(types.ConvertBudget(*budget))
+}
+
+func (s *Server) createBudget(apiContext api.Context) error {
+	var manifest types2.BudgetManifest
+	if err := apiContext.Read(&manifest); err != nil {
+		return err
+	}
+
+	budget := new(types.Budget)
+	if err := s.setBudgetManifest(apiContext, budget, manifest); err != nil {
+		return err
+	}
+
+	if err := s.client.CreateBudget(apiContext.Context(), budget); err != nil {
+		return err
+	}
+
+	return apiContext.WriteCreated(types.ConvertBudget(*budget))
+}
+
+func (s *Server) updateBudget(apiContext api.Context) error {
+	budget, err := s.budgetByID(apiContext)
+	if err != nil {
+		return err
+	}
+
+	var manifest types2.BudgetManifest
+	if err := apiContext.Read(&manifest); err != nil {
+		return err
+	}
+
+	if err := s.setBudgetManifest(apiContext, budget, manifest); err != nil {
+		return err
+	}
+
+	if err := s.client.UpdateBudget(apiContext.Context(), budget); err != nil {
+		return err
+	}
+
+	return apiContext.Write(types.ConvertBudget(*budget))
+}
+
+func (s *Server) deleteBudget(apiContext api.Context) error {
+	budget, err := s.budgetByID(apiContext)
+	if err != nil {
+		return err
+	}
+
+	if err := s.client.DeleteBudget(apiContext.Context(), fmt.Sprint(budget.ID)); err != nil {
+		return err
+	}
+
+	apiContext.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) remainingBudget(apiContext api.Context) error {
+	budget, err := s.budgetByID(apiContext)
+	if err != nil {
+		return err
+	}
+
+	remaining, err := s.client.RemainingBudget(apiContext.Context(), *budget, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return apiContext.Write(types.ConvertRemainingBudget(budget.ID, remaining))
+}
+
+func (s *Server) budgetByID(apiContext api.Context) (*types.Budget, error) {
+	id := apiContext.PathValue("id")
+	budget, err := s.client.BudgetByID(apiContext.Context(), id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, types2.NewErrNotFound("budget %s not found", id)
+	}
+	return budget, err
+}
+
+// setBudgetManifest validates the manifest and sets it on the budget. The model is stored by its ID, so that it
+// matches the model that usage is recorded for.
+func (s *Server) setBudgetManifest(apiContext api.Context, budget *types.Budget, manifest types2.BudgetManifest) error {
+	switch manifest.Window {
+	case types2.BudgetWindowDaily, types2.BudgetWindowWeekly, types2.BudgetWindowMonthly:
+	default:
+		return types2.NewErrBadRequest("invalid window %q: must be daily, weekly, or monthly", manifest.Window)
+	}
+
+	for _, limit := range []types2.BudgetLimit{manifest.Soft, manifest.Hard} {
+		if limit.Tokens < 0 || limit.Cost < 0 {
+			return types2.NewErrBadRequest("budget limits must not be negative")
+		}
+	}
+	if manifest.Soft == (types2.BudgetLimit{}) && manifest.Hard == (types2.BudgetLimit{}) {
+		return types2.NewErrBadRequest("a soft or hard limit is required")
+	}
+
+	if manifest.Model != "" {
+		var model v1.Model
+		if err := alias.Get(apiContext.Context(), s.storageClient, &model, system.DefaultNamespace, manifest.Model); err != nil {
+			return types2.NewErrBadRequest("invalid model %q: %v", manifest.Model, err)
+		}
+		manifest.Model = model.Name
+	}
+
+	budget.Name = manifest.Name
+	budget.ProjectID = manifest.ProjectID
+	budget.AgentID = manifest.AgentID
+	budget.UserIDs = manifest.UserIDs
+	budget.Model = manifest.Model
+	budget.Window = manifest.Window
+	budget.Soft = manifest.Soft
+	budget.Hard = manifest.Hard
+	return nil
+}
+
+// checkBudgets returns an error if a budget that the request counts towards is exceeded, and sets a warning header for
+// each budget that has reached its soft limit. A model with an exceeded budget is removed from the route instead, so
+// that the request is sent to the fallbacks of the model.
+func (s *Server) checkBudgets(apiContext api.Context, token *jwt.TokenContext, route *dispatcher.Route) error {
+	budgets, err := s.client.BudgetsFor(apiContext.Context(), token.ProjectID, token.AgentID, token.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get budgets: %w", err)
+	}
+
+	now := time.Now()
+	for _, budget := range budgets {
+		if budget.Model != "" && !slices.Contains(route.Models(), budget.Model) {
+			continue
+		}
+
+		remaining, err := s.client.RemainingBudget(apiContext.Context(), budget, now)
+		if err != nil {
+			return fmt.Errorf("failed to get usage of budget %d: %w", budget.ID, err)
+		}
+
+		name := budget.Name
+		if name == "" {
+			name = fmt.Sprint(budget.ID)
+		}
+
+		switch remaining.State {
+		case types2.BudgetStateExceeded:
+			if budget.Model != "" {
+				route.Exclude(budget.Model)
+				if len(route.Models()) > 0 {
+					continue
+				}
+			}
+			return types2.NewErrHTTP(http.StatusTooManyRequests, fmt.Sprintf("budget %q is exceeded until %s", name, remaining.End.Format(time.RFC3339)))
+		case types2.BudgetStateWarning:
+			apiContext.ResponseWriter.Header().Add(budgetWarningHeader, name)
+		}
+	}
+
+	return nil
+}
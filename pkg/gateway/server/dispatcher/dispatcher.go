@@ -15,7 +15,6 @@ import (
 	"github.com/gptscript-ai/go-gptscript"
 	"github.com/gptscript-ai/gptscript/pkg/engine"
 	"github.com/obot-platform/obot/apiclient/types"
-	"github.com/obot-platform/obot/pkg/alias"
 	"github.com/obot-platform/obot/pkg/api/handlers/providers"
 	"github.com/obot-platform/obot/pkg/gateway/client"
 	"github.com/obot-platform/obot/pkg/invoke"
@@ -223,67 +222,16 @@ func stopProvider(namespace, name string, urlMap map[string]url.URL, lock *sync.
 	delete(urlMap, key)
 }
 
-func (d *Dispatcher) TransformRequest(req *http.Request, namespace string) error {
-	body, err := readBody(req)
-	if err != nil {
-		return fmt.Errorf("failed to read body: %w", err)
-	}
-
-	modelStr, ok := body["model"].(string)
-	if !ok {
-		return fmt.Errorf("missing model in body")
-	}
-
-	model, err := d.getModelProviderForModel(req.Context(), namespace, modelStr)
-	if err != nil {
-		return fmt.Errorf("failed to get model: %w", err)
-	}
-
-	u, err := d.urlForModelProvider(req.Context(), namespace, model.Spec.Manifest.ModelProvider)
-	if err != nil {
-		return fmt.Errorf("failed to get model provider: %w", err)
-	}
-
-	return d.transformRequest(req, u, body, model.Spec.Manifest.TargetModel)
-}
-
-func (d *Dispatcher) getModelProviderForModel(ctx context.Context, namespace, model string) (*v1.Model, error) {
-	m, err := alias.GetFromScope(ctx, d.client, "Model", namespace, model)
-	if err != nil {
-		return nil, err
-	}
-
-	var respModel *v1.Model
-	switch m := m.(type) {
-	case *v1.DefaultModelAlias:
-		if m.Spec.Manifest.Model == "" {
-			return nil, fmt.Errorf("default model alias %q is not configured", model)
-		}
-		var model v1.Model
-		if err := alias.Get(ctx, d.client, &model, namespace, m.Spec.Manifest.Model); err != nil {
-			return nil, err
-		}
-		respModel = &model
-	case *v1.Model:
-		respModel = m
-	}
-
-	if respModel != nil {
-		if !respModel.Spec.Manifest.Active {
-			return nil, fmt.Errorf("model %q is not active", respModel.Spec.Manifest.Name)
-		}
-
-		return respModel, nil
-	}
-
-	return nil, fmt.Errorf("model %q not found", model)
-}
-
 func (d *Dispatcher) transformRequest(req *http.Request, u url.URL, body map[string]any, targetModel string) error {
 	if u.Path == "" {
 		u.Path = "/v1"
 	}
-	u.Path = path.Join(u.Path, req.PathValue("path"))
+	p := req.PathValue("path")
+	if strings.HasSuffix(u.Path, "/v1") {
+		// Clients of the Anthropic API include the version in the path, and the URL of the provider already has it.
+		p = strings.TrimPrefix(p, "v1/")
+	}
+	u.Path = path.Join(u.Path, p)
 	req.URL = &u
 	req.Host = u.Host
 
@@ -0,0 +1,238 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/obot-platform/obot/logger"
+	"github.com/obot-platform/obot/pkg/alias"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+)
+
+var log = logger.Package()
+
+const (
+	// defaultFallbackTimeout is how long to wait for the response of a model to start before trying the next fallback.
+	defaultFallbackTimeout = 60 * time.Second
+	// nonStreamingFallbackTimeout is the least time that requests which are not streamed are given before trying the
+	// next fallback, because their response only starts once the model has generated all of it.
+	nonStreamingFallbackTimeout = 10 * time.Minute
+)
+
+// Route is the model that a request to the LLM proxy is sent to, and the fallbacks of its alias that the request is
+// sent to if the model fails. It is the transport of the proxy, so that a failed request is retried before anything
+// is written to the client.
+type Route struct {
+	d         *Dispatcher
+	namespace string
+	body      map[string]any
+	models    []*v1.Model
+	timeout   time.Duration
+	transport http.RoundTripper
+	// model is the model whose response was returned by RoundTrip.
+	model *v1.Model
+}
+
+// Route reads the body of the request and returns the models that it can be sent to, in order.
+func (d *Dispatcher) Route(req *http.Request, namespace string) (*Route, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	modelStr, ok := body["model"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing model in body")
+	}
+
+	models, timeout, err := d.getModelsForModel(req.Context(), namespace, modelStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model: %w", err)
+	}
+
+	return &Route{
+		d:         d,
+		namespace: namespace,
+		body:      body,
+		models:    models,
+		timeout:   timeout,
+		transport: http.DefaultTransport,
+	}, nil
+}
+
+// getModelsForModel returns the model, or the model and fallbacks of the alias, that are active. Fallbacks that are
+// not found or not active are skipped, so that one misconfigured fallback does not stop the others from being used.
+func (d *Dispatcher) getModelsForModel(ctx context.Context, namespace, model string) ([]*v1.Model, time.Duration, error) {
+	m, err := alias.GetFromScope(ctx, d.client, "Model", namespace, model)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch m := m.(type) {
+	case *v1.DefaultModelAlias:
+		if m.Spec.Manifest.Model == "" {
+			return nil, 0, fmt.Errorf("default model alias %q is not configured", model)
+		}
+
+		timeout := defaultFallbackTimeout
+		if m.Spec.Manifest.FallbackTimeout != "" {
+			if timeout, err = time.ParseDuration(m.Spec.Manifest.FallbackTimeout); err != nil {
+				return nil, 0, fmt.Errorf("invalid fallback timeout for default model alias %q: %w", model, err)
+			}
+		}
+
+		var (
+			models []*v1.Model
+			errs   []error
+		)
+		for _, name := range append([]string{m.Spec.Manifest.Model}, m.Spec.Manifest.Fallbacks...) {
+			var model v1.Model
+			if err := alias.Get(ctx, d.client, &model, namespace, name); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if !model.Spec.Manifest.Active {
+				errs = append(errs, fmt.Errorf("model %q is not active", model.Spec.Manifest.Name))
+				continue
+			}
+			models = append(models, &model)
+		}
+		if len(models) == 0 {
+			return nil, 0, errors.Join(errs...)
+		}
+		return models, timeout, nil
+	case *v1.Model:
+		if !m.Spec.Manifest.Active {
+			return nil, 0, fmt.Errorf("model %q is not active", m.Spec.Manifest.Name)
+		}
+		return []*v1.Model{m}, 0, nil
+	}
+
+	return nil, 0, fmt.Errorf("model %q not found", model)
+}
+
+// RoundTrip sends the request to each model in order until one responds with something other than a rate limit or a
+// server error, or the last model is reached.
+func (r *Route) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		resp *http.Response
+		err  error
+	)
+	for i, model := range r.models {
+		last := i == len(r.models)-1
+
+		resp, err = r.send(req, model, last)
+		if last || !retryable(resp, err) {
+			r.model = model
+			break
+		}
+
+		if err != nil {
+			log.Warnf("Model %s failed, trying fallback model %s: %v", model.Name, r.models[i+1].Name, err)
+		} else {
+			log.Warnf("Model %s responded with status %d, trying fallback model %s", model.Name, resp.StatusCode, r.models[i+1].Name)
+			_ = resp.Body.Close()
+		}
+	}
+
+	return resp, err
+}
+
+// Body returns the decoded body of the request.
+func (r *Route) Body() map[string]any {
+	return r.body
+}
+
+// First returns the model that the request is sent to first, or nil if there is none.
+func (r *Route) First() *v1.Model {
+	if len(r.models) == 0 {
+		return nil
+	}
+	return r.models[0]
+}
+
+// Models returns the IDs of the models that the request can be sent to, in order.
+func (r *Route) Models() []string {
+	names := make([]string, 0, len(r.models))
+	for _, model := range r.models {
+		names = append(names, model.Name)
+	}
+	return names
+}
+
+// Exclude removes the model from the models that the request can be sent to.
+func (r *Route) Exclude(name string) {
+	r.models = slices.DeleteFunc(r.models, func(model *v1.Model) bool {
+		return model.Name == name
+	})
+}
+
+// Model returns the model that responded to the request, or nil if the request has not been sent.
+func (r *Route) Model() *v1.Model {
+	return r.model
+}
+
+// send sends the request to the model. Unless it is the last model, the request is canceled if its response does not
+// start within the fallback timeout, or within nonStreamingFallbackTimeout if the request is not streamed.
+func (r *Route) send(req *http.Request, model *v1.Model, last bool) (*http.Response, error) {
+	u, err := r.d.urlForModelProvider(req.Context(), r.namespace, model.Spec.Manifest.ModelProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model provider: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	out := req.Clone(ctx)
+	if err := r.d.transformRequest(out, u, r.body, model.Spec.Manifest.TargetModel); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	timeout := r.timeout
+	if stream, _ := r.body["stream"].(bool); !stream && timeout > 0 {
+		timeout = max(timeout, nonStreamingFallbackTimeout)
+	}
+
+	var timer *time.Timer
+	if !last && timeout > 0 {
+		timer = time.AfterFunc(timeout, cancel)
+	}
+
+	resp, err := r.transport.RoundTrip(out)
+	if timer != nil && !timer.Stop() {
+		// The timeout fired, so the request was canceled even if its response arrived.
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		resp, err = nil, fmt.Errorf("response did not start within %s", timeout)
+	}
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// cancelOnClose cancels the context of a request when its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
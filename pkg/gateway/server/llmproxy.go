@@ -16,14 +16,21 @@ import (
 	types2 "github.com/obot-platform/obot/apiclient/types"
 	"github.com/obot-platform/obot/pkg/api"
 	"github.com/obot-platform/obot/pkg/gateway/client"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
 	"github.com/obot-platform/obot/pkg/gateway/types"
-	"github.com/tidwall/gjson"
 )
 
 const tokenUsageTimePeriod = 24 * time.Hour
 
 func (s *Server) llmProxy(req api.Context) error {
-	token, err := s.tokenService.DecodeToken(strings.TrimPrefix(req.Request.Header.Get("Authorization"), "Bearer "))
+	// Clients of the Anthropic API send the token in the x-api-key header instead.
+	bearer := strings.TrimPrefix(req.Request.Header.Get("Authorization"), "Bearer ")
+	if bearer == "" {
+		bearer = req.Request.Header.Get("X-Api-Key")
+		req.Request.Header.Del("X-Api-Key")
+	}
+
+	token, err := s.tokenService.DecodeToken(bearer)
 	if err != nil {
 		return types2.NewErrHTTP(http.StatusUnauthorized, fmt.Sprintf("invalid token: %v", err))
 	}
@@ -37,6 +44,26 @@ func (s *Server) llmProxy(req api.Context) error {
 		}
 	}
 
+	route, err := s.dispatcher.Route(req.Request, token.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var cached *types.LLMResponseCacheEntry
+	cache := s.responseCacheFor(req, token, route)
+	if cache != nil {
+		if cached, err = s.client.CachedResponse(req.Context(), cache.hash); err != nil {
+			return fmt.Errorf("failed to get cached response: %w", err)
+		}
+	}
+
+	// A cached response doesn't use any tokens, so it is returned even if a budget is exceeded.
+	if cached == nil {
+		if err := s.checkBudgets(req, token, route); err != nil {
+			return err
+		}
+	}
+
 	if err = s.db.WithContext(req.Context()).Create(&types.LLMProxyActivity{
 		UserID:         token.UserID,
 		WorkflowID:     token.WorkflowID,
@@ -45,103 +72,207 @@ func (s *Server) llmProxy(req api.Context) error {
 		ThreadID:       token.ThreadID,
 		RunID:          token.RunID,
 		Path:           req.URL.Path,
+		CacheHit:       cached != nil,
 	}).Error; err != nil {
 		return fmt.Errorf("failed to create monitor: %w", err)
 	}
 
-	errChan := make(chan error, 1)
+	if cached != nil {
+		writeCachedResponse(req, cached)
+		return nil
+	}
+
+	// The route sends the request to the model, or to the fallbacks of the model if it fails.
 	(&httputil.ReverseProxy{
-		Director:       s.newDirector(token.Namespace, errChan),
-		ModifyResponse: (&responseModifier{userID: token.UserID, runID: token.RunID, client: s.client}).modifyResponse,
+		Director:  func(*http.Request) {},
+		Transport: route,
+		ModifyResponse: (&responseModifier{
+			userID:     token.UserID,
+			runID:      token.RunID,
+			projectID:  token.ProjectID,
+			agentID:    token.AgentID,
+			workflowID: token.WorkflowID,
+			route:      route,
+			cache:      cache,
+			client:     s.client,
+		}).modifyResponse,
 	}).ServeHTTP(req.ResponseWriter, req.Request)
 
-	return <-errChan
-}
-
-func (s *Server) newDirector(namespace string, errChan chan<- error) func(req *http.Request) {
-	return func(req *http.Request) {
-		errChan <- s.dispatcher.TransformRequest(req, namespace)
-	}
+	return nil
 }
 
 type responseModifier struct {
-	userID, runID                               string
-	client                                      *client.Client
-	lock                                        sync.Mutex
-	promptTokens, completionTokens, totalTokens int
-	b                                           *bufio.Reader
-	c                                           io.Closer
-	stream                                      bool
+	userID, runID, projectID, agentID, workflowID                   string
+	route                                                           *dispatcher.Route
+	client                                                          *client.Client
+	model                                                           string
+	pricing                                                         *types2.ModelPricing
+	lock                                                            sync.Mutex
+	promptTokens, cachedPromptTokens, completionTokens, totalTokens int
+	b                                                               *bufio.Reader
+	c                                                               io.Closer
+	stream, cumulative                                              bool
+	// pending is the rest of the line that was read but not returned yet, and err is the error that is returned
+	// after it.
+	pending []byte
+	err     error
+	// body is the response so far, if it is not streamed, because its usage can only be read once it is complete.
+	body []byte
+	// cache is where the response is cached, and cached is the response that was read so far.
+	cache       *responseCache
+	cached      []byte
+	contentType string
+	readAll     bool
 }
 
 func (r *responseModifier) modifyResponse(resp *http.Response) error {
-	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/v1/chat/completions" {
+	cumulative, ok := meteredPaths[resp.Request.URL.Path]
+	if resp.StatusCode != http.StatusOK || !ok {
 		return nil
 	}
 
+	if model := r.route.Model(); model != nil {
+		r.model = model.Name
+		r.pricing = model.Spec.Manifest.Pricing
+	}
+
+	if r.cache != nil {
+		if r.model != r.cache.model {
+			// A fallback responded, so the response is not cached for the model of the request.
+			r.cache = nil
+		} else {
+			r.contentType = resp.Header.Get("Content-Type")
+			resp.Header.Set(cacheHeader, "miss")
+		}
+	}
+
 	r.c = resp.Body
 	r.b = bufio.NewReader(resp.Body)
 	r.stream = strings.Contains(resp.Header.Get("Content-Type"), "text/event-stream")
+	r.cumulative = cumulative
 	resp.Body = r
 
 	return nil
 }
 
 func (r *responseModifier) Read(p []byte) (int, error) {
-	line, err := r.b.ReadBytes('\n')
-	if len(line) > 0 && errors.Is(err, io.EOF) {
-		// Don't send an EOF until we read everything.
-		err = nil
-	}
-	if err != nil {
-		return copy(p, line), err
+	n, err := r.read(p)
+	if r.cache != nil {
+		r.cached = append(r.cached, p[:n]...)
+		if len(r.cached) > maxCachedResponseSize {
+			r.cache, r.cached = nil, nil
+		}
+		r.readAll = errors.Is(err, io.EOF)
 	}
+	return n, err
+}
+
+// read returns the response a line at a time, and records the usage in each event of a stream, or in the body of a
+// response that is not streamed once all of it is read.
+func (r *responseModifier) read(p []byte) (int, error) {
+	if len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
 
-	var prefix []byte
-	if r.stream {
-		prefix = []byte("data: ")
-		rest, ok := bytes.CutPrefix(line, prefix)
-		if !ok {
-			// This isn't a data line, so send it through.
-			return copy(p, line), nil
+		line, err := r.b.ReadBytes('\n')
+		r.err = err
+		if r.stream {
+			if data, ok := bytes.CutPrefix(line, []byte("data: ")); ok {
+				r.addUsage(data)
+			}
+		} else {
+			r.body = append(r.body, line...)
+			if errors.Is(err, io.EOF) {
+				r.addUsage(r.body)
+			}
 		}
-		line = rest
+
+		if len(line) == 0 {
+			return 0, err
+		}
+		r.pending = line
 	}
 
-	usage := gjson.GetBytes(line, "usage")
-	promptTokens := usage.Get("prompt_tokens").Int()
-	completionTokens := usage.Get("completion_tokens").Int()
-	totalTokens := usage.Get("total_tokens").Int()
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
 
-	if promptTokens > 0 || completionTokens > 0 || totalTokens > 0 {
-		r.lock.Lock()
-		r.promptTokens += int(promptTokens)
-		r.completionTokens += int(completionTokens)
-		r.totalTokens += int(totalTokens)
-		r.lock.Unlock()
+func (r *responseModifier) addUsage(data []byte) {
+	usage, ok := usageFrom(data)
+	if !ok {
+		return
 	}
 
-	var n int
-	if len(prefix) > 0 {
-		n = copy(p, prefix)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cumulative {
+		r.promptTokens = max(r.promptTokens, usage.prompt)
+		r.cachedPromptTokens = max(r.cachedPromptTokens, usage.cachedPrompt)
+		r.completionTokens = max(r.completionTokens, usage.completion)
+		r.totalTokens = r.promptTokens + r.completionTokens
+		return
 	}
 
-	n += copy(p[n:], line)
-	return n, nil
+	r.promptTokens += usage.prompt
+	r.cachedPromptTokens += usage.cachedPrompt
+	r.completionTokens += usage.completion
+	r.totalTokens += usage.total
 }
 
 func (r *responseModifier) Close() error {
 	r.lock.Lock()
 	activity := &types.RunTokenActivity{
-		Name:             r.runID,
-		UserID:           r.userID,
-		PromptTokens:     r.promptTokens,
-		CompletionTokens: r.completionTokens,
-		TotalTokens:      r.totalTokens,
+		Name:               r.runID,
+		UserID:             r.userID,
+		ProjectID:          r.projectID,
+		AgentID:            r.agentID,
+		WorkflowID:         r.workflowID,
+		Model:              r.model,
+		PromptTokens:       r.promptTokens,
+		CachedPromptTokens: r.cachedPromptTokens,
+		CompletionTokens:   r.completionTokens,
+		TotalTokens:        r.totalTokens,
+		Cost:               cost(r.pricing, r.promptTokens, r.cachedPromptTokens, r.completionTokens),
 	}
 	r.lock.Unlock()
 	if err := r.client.InsertTokenUsage(context.Background(), activity); err != nil {
 		logger.Warnf("failed to save token usage for run %s: %v", r.runID, err)
 	}
+
+	// Only complete responses are cached, because the client can close the response before it is done.
+	if r.cache != nil && r.readAll {
+		now := time.Now()
+		if err := r.client.CacheResponse(context.Background(), &types.LLMResponseCacheEntry{
+			Hash:        r.cache.hash,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(r.cache.ttl),
+			Model:       r.cache.model,
+			ContentType: r.contentType,
+			Body:        r.cached,
+		}); err != nil {
+			logger.Warnf("failed to cache response for run %s: %v", r.runID, err)
+		}
+	}
+
 	return r.c.Close()
 }
+
+// cost returns the cost in dollars of the tokens. The cached prompt tokens are part of the prompt tokens, and are
+// charged at the cached input price if the model has one.
+func cost(pricing *types2.ModelPricing, promptTokens, cachedPromptTokens, completionTokens int) float64 {
+	if pricing == nil {
+		return 0
+	}
+
+	cachedInputPerMillion := pricing.CachedInputPerMillion
+	if cachedInputPerMillion == 0 {
+		cachedInputPerMillion = pricing.InputPerMillion
+	}
+
+	return (float64(promptTokens-cachedPromptTokens)*pricing.InputPerMillion +
+		float64(cachedPromptTokens)*cachedInputPerMillion +
+		float64(completionTokens)*pricing.OutputPerMillion) / 1_000_000
+}
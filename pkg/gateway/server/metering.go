@@ -0,0 +1,51 @@
+package server
+
+import "github.com/tidwall/gjson"
+
+// meteredPaths are the paths of the APIs that the LLM proxy records the token usage of, and whether the usage in
+// their streams is cumulative. Anthropic message streams report the usage so far in their events, so the latest
+// counts replace the earlier ones instead of being added to them.
+var meteredPaths = map[string]bool{
+	"/v1/chat/completions":   false,
+	"/v1/embeddings":         false,
+	"/v1/responses":          false,
+	"/v1/images/generations": false,
+	"/v1/messages":           true,
+}
+
+type tokenCounts struct {
+	prompt, cachedPrompt, completion, total int
+}
+
+// usageFrom returns the token usage in a response, or in an event of a streamed response. Chat completions and
+// embeddings count prompt and completion tokens, while the responses API, image generation, and Anthropic messages
+// count input and output tokens.
+func usageFrom(data []byte) (tokenCounts, bool) {
+	var usage gjson.Result
+	for _, path := range []string{"usage", "message.usage", "response.usage"} {
+		if usage = gjson.GetBytes(data, path); usage.IsObject() {
+			break
+		}
+	}
+	if !usage.IsObject() {
+		return tokenCounts{}, false
+	}
+
+	counts := tokenCounts{
+		prompt:       int(usage.Get("prompt_tokens").Int() + usage.Get("input_tokens").Int()),
+		cachedPrompt: int(usage.Get("prompt_tokens_details.cached_tokens").Int() + usage.Get("input_tokens_details.cached_tokens").Int()),
+		completion:   int(usage.Get("completion_tokens").Int() + usage.Get("output_tokens").Int()),
+		total:        int(usage.Get("total_tokens").Int()),
+	}
+
+	// Anthropic doesn't count the tokens that are read from or written to the prompt cache as input tokens.
+	cacheRead := int(usage.Get("cache_read_input_tokens").Int())
+	counts.prompt += cacheRead + int(usage.Get("cache_creation_input_tokens").Int())
+	counts.cachedPrompt += cacheRead
+
+	if counts.total == 0 {
+		counts.total = counts.prompt + counts.completion
+	}
+
+	return counts, counts != tokenCounts{}
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		want   tokenCounts
+		wantOK bool
+	}{
+		{
+			name:   "chat completion",
+			data:   `{"id":"chatcmpl-B9MBs8CjcvOU2jLn4n570S5qMJKcT","object":"chat.completion","created":1741569952,"model":"gpt-4.1-2025-04-14","choices":[{"index":0,"message":{"role":"assistant","content":"Hello! How can I assist you today?","refusal":null,"annotations":[]},"logprobs":null,"finish_reason":"stop"}],"usage":{"prompt_tokens":19,"completion_tokens":10,"total_tokens":29,"prompt_tokens_details":{"cached_tokens":0,"audio_tokens":0},"completion_tokens_details":{"reasoning_tokens":0,"audio_tokens":0,"accepted_prediction_tokens":0,"rejected_prediction_tokens":0}},"service_tier":"default"}`,
+			want:   tokenCounts{prompt: 19, completion: 10, total: 29},
+			wantOK: true,
+		},
+		{
+			name: "chat completion chunk without usage",
+			data: `{"id":"chatcmpl-123","object":"chat.completion.chunk","created":1694268190,"model":"gpt-4o-mini","system_fingerprint":"fp_44709d6fcb","choices":[{"index":0,"delta":{"content":"Hello"},"logprobs":null,"finish_reason":null}],"usage":null}`,
+		},
+		{
+			name:   "last chat completion chunk with cached tokens",
+			data:   `{"id":"chatcmpl-123","object":"chat.completion.chunk","created":1694268190,"model":"gpt-4o-mini","system_fingerprint":"fp_44709d6fcb","choices":[],"usage":{"prompt_tokens":2006,"completion_tokens":300,"total_tokens":2306,"prompt_tokens_details":{"cached_tokens":1920}}}`,
+			want:   tokenCounts{prompt: 2006, cachedPrompt: 1920, completion: 300, total: 2306},
+			wantOK: true,
+		},
+		{
+			name:   "embeddings",
+			data:   `{"object":"list","data":[{"object":"embedding","index":0,"embedding":[0.0023064255,-0.009327292,-0.0028842222]}],"model":"text-embedding-3-small","usage":{"prompt_tokens":8,"total_tokens":8}}`,
+			want:   tokenCounts{prompt: 8, total: 8},
+			wantOK: true,
+		},
+		{
+			name:   "response",
+			data:   `{"id":"resp_67ccd2bed1ec8190b14f964abc0542670bb6a6b452d3795b","object":"response","created_at":1741476542,"status":"completed","model":"gpt-4.1-2025-04-14","output":[{"type":"message","id":"msg_67ccd2bf17f0819081ff3bb2cf6508e60bb6a6b452d3795b","status":"completed","role":"assistant","content":[{"type":"output_text","text":"In a peaceful grove beneath a silver moon...","annotations":[]}]}],"usage":{"input_tokens":36,"input_tokens_details":{"cached_tokens":0},"output_tokens":87,"output_tokens_details":{"reasoning_tokens":0},"total_tokens":123}}`,
+			want:   tokenCounts{prompt: 36, completion: 87, total: 123},
+			wantOK: true,
+		},
+		{
+			name: "response.created",
+			data: `{"type":"response.created","sequence_number":0,"response":{"id":"resp_67c9fdcecf488190bdd9a0409de3a1ec07b8b0ad4e5eb654","object":"response","created_at":1741290958,"status":"in_progress","model":"gpt-4.1-2025-04-14","output":[],"usage":null}}`,
+		},
+		{
+			name: "response.output_text.delta",
+			data: `{"type":"response.output_text.delta","sequence_number":4,"item_id":"msg_123","output_index":0,"content_index":0,"delta":"Hi"}`,
+		},
+		{
+			name:   "response.completed",
+			data:   `{"type":"response.completed","sequence_number":20,"response":{"id":"resp_123","object":"response","created_at":1740855869,"status":"completed","model":"gpt-4o-mini-2024-07-18","output":[{"id":"msg_123","type":"message","role":"assistant","content":[{"type":"output_text","text":"In a shimmering forest under a sky full of stars...","annotations":[]}]}],"usage":{"input_tokens":37,"input_tokens_details":{"cached_tokens":12},"output_tokens":11,"output_tokens_details":{"reasoning_tokens":0},"total_tokens":48}}}`,
+			want:   tokenCounts{prompt: 37, cachedPrompt: 12, completion: 11, total: 48},
+			wantOK: true,
+		},
+		{
+			name:   "image generation",
+			data:   `{"created":1713833628,"data":[{"b64_json":"aGVsbG8="}],"usage":{"total_tokens":100,"input_tokens":50,"output_tokens":50,"input_tokens_details":{"text_tokens":10,"image_tokens":40}}}`,
+			want:   tokenCounts{prompt: 50, completion: 50, total: 100},
+			wantOK: true,
+		},
+		{
+			name:   "anthropic message",
+			data:   `{"id":"msg_013Zva2CMHLNnXjNJJKqJ2EF","type":"message","role":"assistant","model":"claude-sonnet-4-20250514","content":[{"type":"text","text":"Hi! My name is Claude."}],"stop_reason":"end_turn","stop_sequence":null,"usage":{"input_tokens":2095,"cache_creation_input_tokens":0,"cache_read_input_tokens":0,"output_tokens":503}}`,
+			want:   tokenCounts{prompt: 2095, completion: 503, total: 2598},
+			wantOK: true,
+		},
+		{
+			name:   "anthropic message_start with the prompt cache",
+			data:   `{"type":"message_start","message":{"id":"msg_1nZdL29xx5MUA1yADyHTEsnR8uuvGzszyY","type":"message","role":"assistant","content":[],"model":"claude-opus-4-1-20250805","stop_reason":null,"stop_sequence":null,"usage":{"input_tokens":25,"cache_creation_input_tokens":100,"cache_read_input_tokens":2000,"output_tokens":1}}}`,
+			want:   tokenCounts{prompt: 2125, cachedPrompt: 2000, completion: 1, total: 2126},
+			wantOK: true,
+		},
+		{
+			name: "anthropic content_block_delta",
+			data: `{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hello"}}`,
+		},
+		{
+			name:   "anthropic message_delta",
+			data:   `{"type":"message_delta","delta":{"stop_reason":"end_turn","stop_sequence":null},"usage":{"output_tokens":15}}`,
+			want:   tokenCounts{completion: 15, total: 15},
+			wantOK: true,
+		},
+		{
+			name: "anthropic ping",
+			data: `{"type":"ping"}`,
+		},
+		{
+			name: "end of a chat completion stream",
+			data: `[DONE]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := usageFrom([]byte(tt.data))
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddUsage(t *testing.T) {
+	tests := []struct {
+		name                                    string
+		cumulative                              bool
+		events                                  []string
+		prompt, cachedPrompt, completion, total int
+	}{
+		{
+			name:       "anthropic message stream",
+			cumulative: true,
+			events: []string{
+				`{"type":"message_start","message":{"id":"msg_1","type":"message","role":"assistant","content":[],"model":"claude-opus-4-1-20250805","usage":{"input_tokens":25,"cache_creation_input_tokens":100,"cache_read_input_tokens":2000,"output_tokens":1}}}`,
+				`{"type":"content_block_delta","index":0,"delta":{"type":"text_delta","text":"Hello"}}`,
+				`{"type":"message_delta","delta":{"stop_reason":"end_turn","stop_sequence":null},"usage":{"output_tokens":15}}`,
+				`{"type":"message_stop"}`,
+			},
+			prompt:       2125,
+			cachedPrompt: 2000,
+			completion:   15,
+			total:        2140,
+		},
+		{
+			name: "chat completion stream",
+			events: []string{
+				`{"id":"chatcmpl-123","object":"chat.completion.chunk","choices":[{"index":0,"delta":{"content":"Hello"},"finish_reason":null}],"usage":null}`,
+				`{"id":"chatcmpl-123","object":"chat.completion.chunk","choices":[{"index":0,"delta":{},"finish_reason":"stop"}],"usage":null}`,
+				`{"id":"chatcmpl-123","object":"chat.completion.chunk","choices":[],"usage":{"prompt_tokens":2006,"completion_tokens":300,"total_tokens":2306,"prompt_tokens_details":{"cached_tokens":1920}}}`,
+				`[DONE]`,
+			},
+			prompt:       2006,
+			cachedPrompt: 1920,
+			completion:   300,
+			total:        2306,
+		},
+		{
+			name: "response stream",
+			events: []string{
+				`{"type":"response.created","sequence_number":0,"response":{"id":"resp_123","object":"response","status":"in_progress","output":[],"usage":null}}`,
+				`{"type":"response.output_text.delta","sequence_number":4,"item_id":"msg_123","output_index":0,"content_index":0,"delta":"Hi"}`,
+				`{"type":"response.completed","sequence_number":20,"response":{"id":"resp_123","object":"response","status":"completed","usage":{"input_tokens":37,"input_tokens_details":{"cached_tokens":12},"output_tokens":11,"output_tokens_details":{"reasoning_tokens":0},"total_tokens":48}}}`,
+			},
+			prompt:       37,
+			cachedPrompt: 12,
+			completion:   11,
+			total:        48,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &responseModifier{cumulative: tt.cumulative}
+			for _, event := range tt.events {
+				r.addUsage([]byte(event))
+			}
+			require.Equal(t, tt.prompt, r.promptTokens)
+			require.Equal(t, tt.cachedPrompt, r.cachedPromptTokens)
+			require.Equal(t, tt.completion, r.completionTokens)
+			require.Equal(t, tt.total, r.totalTokens)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/obot-platform/obot/pkg/api"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
+	"github.com/obot-platform/obot/pkg/gateway/types"
+	"github.com/obot-platform/obot/pkg/jwt"
+	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// cacheHeader is set on LLM proxy responses of models with a response cache, to hit or miss.
+	cacheHeader = "X-Obot-Cache"
+	// defaultResponseCacheTTL is how long responses are cached if the model does not set a TTL.
+	defaultResponseCacheTTL = 24 * time.Hour
+	// maxCachedResponseSize is the size of the largest response that is cached.
+	maxCachedResponseSize = 1 << 20
+	// responseCacheCleanupInterval is how often expired responses are deleted from the cache.
+	responseCacheCleanupInterval = time.Hour
+)
+
+// uncachedFields are the fields of a request that don't change its response, so they are left out of its hash.
+var uncachedFields = []string{"user", "metadata"}
+
+// responseCache is where the response to a request is cached.
+type responseCache struct {
+	hash  string
+	model string
+	ttl   time.Duration
+}
+
+// responseCacheFor returns where the response to the request is cached, or nil if it is not cached. Responses are
+// cached for chat completion requests with a temperature of 0 to models with a response cache, unless the agent of the
+// run turns caching off.
+func (s *Server) responseCacheFor(req api.Context, token *jwt.TokenContext, route *dispatcher.Route) *responseCache {
+	model := route.First()
+	if model == nil || model.Spec.Manifest.ResponseCache == nil || !model.Spec.Manifest.ResponseCache.Enabled ||
+		req.PathValue("path") != "chat/completions" {
+		return nil
+	}
+
+	body := maps.Clone(route.Body())
+	if temperature, ok := body["temperature"].(float64); !ok || temperature != 0 {
+		return nil
+	}
+
+	if token.AgentID != "" {
+		var agent v1.Agent
+		if err := s.storageClient.Get(req.Context(), kclient.ObjectKey{Namespace: token.Namespace, Name: token.AgentID}, &agent); err == nil &&
+			agent.Spec.Manifest.Cache != nil && !*agent.Spec.Manifest.Cache {
+			return nil
+		}
+	}
+
+	ttl := defaultResponseCacheTTL
+	if t, err := time.ParseDuration(model.Spec.Manifest.ResponseCache.TTL); err == nil && t > 0 {
+		ttl = t
+	}
+
+	// The request is hashed with the model that it is sent to, so that a response is not reused when an alias is
+	// changed to another model. Marshaling sorts the keys of the body, so the order of its fields doesn't matter.
+	body["model"] = model.Name
+	for _, field := range uncachedFields {
+		delete(body, field)
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	hash := sha256.Sum256(b)
+
+	return &responseCache{
+		hash:  hex.EncodeToString(hash[:]),
+		model: model.Name,
+		ttl:   ttl,
+	}
+}
+
+// writeCachedResponse writes the cached response to the request instead of sending it to the model.
+func writeCachedResponse(req api.Context, entry *types.LLMResponseCacheEntry) {
+	req.ResponseWriter.Header().Set("Content-Type", entry.ContentType)
+	req.ResponseWriter.Header().Set(cacheHeader, "hit")
+	req.ResponseWriter.WriteHeader(http.StatusOK)
+	_, _ = req.ResponseWriter.Write(entry.Body)
+}
+
+func (s *Server) autoCleanupResponseCache(ctx context.Context) {
+	ticker := time.NewTicker(responseCacheCleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.client.DeleteExpiredCachedResponses(ctx); err != nil {
+			logger.Errorf("failed to delete expired cached responses: %v", err)
+		}
+	}
+}
@@ -40,9 +40,17 @@ func (s *Server) AddRoutes(mux *server.Server) {
 	mux.HandleFunc("DELETE /api/users/{user_id}", wrap(s.deleteUser))
 	mux.HandleFunc("GET /api/active-users", wrap(s.activeUsers))
 
-	mux.HandleFunc("GET /api/token-usage", wrap(s.systemTokenUsageByUser))
+	mux.HandleFunc("GET /api/token-usage", wrap(s.systemTokenUsage))
 	mux.HandleFunc("GET /api/total-token-usage", wrap(s.totalSystemTokenUsage))
 
+	// CRUD routes for budgets of LLM usage
+	mux.HandleFunc("GET /api/budgets", wrap(s.listBudgets))
+	mux.HandleFunc("GET /api/budgets/{id}", wrap(s.getBudget))
+	mux.HandleFunc("POST /api/budgets", wrap(s.createBudget))
+	mux.HandleFunc("PUT /api/budgets/{id}", wrap(s.updateBudget))
+	mux.HandleFunc("DELETE /api/budgets/{id}", wrap(s.deleteBudget))
+	mux.HandleFunc("GET /api/budgets/{id}/remaining", wrap(s.remainingBudget))
+
 	mux.HandleFunc("POST /api/token-request", s.tokenRequest)
 	mux.HandleFunc("GET /api/token-request/{id}", s.checkForToken)
 	mux.HandleFunc("GET /api/token-request/{id}/{namespace}/{name}", s.redirectForTokenRequest)
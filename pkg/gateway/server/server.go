@@ -49,6 +49,7 @@ func New(ctx context.Context, storageClient kclient.Client, g *gptscript.GPTScri
 
 	go s.autoCleanupTokens(ctx)
 	go s.oAuthCleanup(ctx)
+	go s.autoCleanupResponseCache(ctx)
 
 	return s, nil
 }
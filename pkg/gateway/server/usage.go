@@ -62,7 +62,9 @@ func (s *Server) remainingUsageForUser(apiContext api.Context) error {
 	return apiContext.Write(types.ConvertRemainingTokenUsage(userID, remainingUsage))
 }
 
-func (s *Server) systemTokenUsageByUser(apiContext api.Context) error {
+// systemTokenUsage sums the token usage and its cost by user, or by the project, task, or model in the groupBy query
+// parameter.
+func (s *Server) systemTokenUsage(apiContext api.Context) error {
 	requestedStart := apiContext.Request.URL.Query().Get("start")
 	requestedEnd := apiContext.Request.URL.Query().Get("end")
 
@@ -71,7 +73,7 @@ func (s *Server) systemTokenUsageByUser(apiContext api.Context) error {
 		return err
 	}
 
-	activities, err := apiContext.GatewayClient.TokenUsageByUser(apiContext.Context(), start, end)
+	activities, err := apiContext.GatewayClient.TokenUsageBy(apiContext.Context(), types2.TokenUsageGroupBy(apiContext.Request.URL.Query().Get("groupBy")), start, end)
 	if err != nil {
 		return err
 	}
@@ -102,8 +104,10 @@ func (s *Server) totalSystemTokenUsage(apiContext api.Context) error {
 	var activity types.RunTokenActivity
 	for _, a := range activities {
 		activity.PromptTokens += a.PromptTokens
+		activity.CachedPromptTokens += a.CachedPromptTokens
 		activity.CompletionTokens += a.CompletionTokens
 		activity.TotalTokens += a.TotalTokens
+		activity.Cost += a.Cost
 	}
 
 	return apiContext.Write(types.ConvertTokenActivity(activity))
@@ -16,6 +16,7 @@ type LLMProxyActivity struct {
 	ThreadID         string
 	RunID            string
 	Path             string
+	CacheHit         bool
 	PromptTokens     int
 	CompletionTokens int
 	TotalTokens      int
@@ -34,24 +35,38 @@ func ConvertAPIActivity(a APIActivity) types2.APIActivity {
 	}
 }
 
+// RunTokenActivity is the usage of one LLM request. The columns that budgets and usage reports filter by are indexed.
 type RunTokenActivity struct {
-	ID               uint
-	CreatedAt        time.Time
-	Name             string
-	UserID           string
-	PromptTokens     int
-	CompletionTokens int
-	TotalTokens      int
+	ID        uint
+	CreatedAt time.Time `gorm:"index"`
+	Name      string
+	UserID    string `gorm:"index"`
+	ProjectID string `gorm:"index"`
+	// AgentID is only recorded since budgets were added, so older usage has no agent.
+	AgentID            string `gorm:"index"`
+	WorkflowID         string
+	Model              string `gorm:"index"`
+	PromptTokens       int
+	CachedPromptTokens int
+	CompletionTokens   int
+	TotalTokens        int
+	// Cost is in dollars, computed from the pricing of the model when the usage is recorded.
+	Cost float64
 }
 
 func ConvertTokenActivity(a RunTokenActivity) types2.TokenUsage {
 	return types2.TokenUsage{
-		UserID:           a.UserID,
-		RunName:          a.Name,
-		Date:             *types2.NewTime(a.CreatedAt),
-		PromptTokens:     a.PromptTokens,
-		CompletionTokens: a.CompletionTokens,
-		TotalTokens:      a.TotalTokens,
+		UserID:             a.UserID,
+		RunName:            a.Name,
+		ProjectID:          a.ProjectID,
+		TaskID:             a.WorkflowID,
+		Model:              a.Model,
+		Date:               *types2.NewTime(a.CreatedAt),
+		PromptTokens:       a.PromptTokens,
+		CachedPromptTokens: a.CachedPromptTokens,
+		CompletionTokens:   a.CompletionTokens,
+		TotalTokens:        a.TotalTokens,
+		Cost:               a.Cost,
 	}
 }
 
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	types2 "github.com/obot-platform/obot/apiclient/types"
+)
+
+type Budget struct {
+	ID        uint `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Name      string
+	ProjectID string
+	AgentID   string
+	UserIDs   []string `gorm:"serializer:json"`
+	Model     string
+	Window    types2.BudgetWindow
+	Soft      types2.BudgetLimit `gorm:"embedded;embeddedPrefix:soft_"`
+	Hard      types2.BudgetLimit `gorm:"embedded;embeddedPrefix:hard_"`
+}
+
+func ConvertBudget(b Budget) types2.Budget {
+	return types2.Budget{
+		Metadata: types2.Metadata{
+			ID:      fmt.Sprint(b.ID),
+			Created: *types2.NewTime(b.CreatedAt),
+		},
+		BudgetManifest: types2.BudgetManifest{
+			Name:      b.Name,
+			ProjectID: b.ProjectID,
+			AgentID:   b.AgentID,
+			UserIDs:   b.UserIDs,
+			Model:     b.Model,
+			Window:    b.Window,
+			Soft:      b.Soft,
+			Hard:      b.Hard,
+		},
+	}
+}
+
+// Applies returns whether the usage of the project, agent, and user counts towards the budget. The model of a budget
+// is matched separately, because a request can be sent to more than one model.
+func (b Budget) Applies(projectID, agentID, userID string) bool {
+	return (b.ProjectID == "" || b.ProjectID == projectID) &&
+		(b.AgentID == "" || b.AgentID == agentID) &&
+		(len(b.UserIDs) == 0 || slices.Contains(b.UserIDs, userID))
+}
+
+// CurrentWindow returns the start and end of the window of the budget that contains now. Windows start at midnight
+// UTC, weeks start on Monday, and months start on the first day of the month.
+func (b Budget) CurrentWindow(now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch b.Window {
+	case types2.BudgetWindowWeekly:
+		start := day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+		return start, start.AddDate(0, 0, 7)
+	case types2.BudgetWindowMonthly:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+	return day, day.AddDate(0, 0, 1)
+}
+
+type RemainingBudget struct {
+	Start, End      time.Time
+	UsedTokens      int
+	UsedCost        float64
+	RemainingTokens int
+	RemainingCost   float64
+	UnlimitedTokens bool
+	UnlimitedCost   bool
+	State           types2.BudgetState
+}
+
+func ConvertRemainingBudget(budgetID uint, r *RemainingBudget) types2.RemainingBudget {
+	return types2.RemainingBudget{
+		BudgetID:        fmt.Sprint(budgetID),
+		Start:           *types2.NewTime(r.Start),
+		End:             *types2.NewTime(r.End),
+		UsedTokens:      r.UsedTokens,
+		UsedCost:        r.UsedCost,
+		RemainingTokens: r.RemainingTokens,
+		RemainingCost:   r.RemainingCost,
+		UnlimitedTokens: r.UnlimitedTokens,
+		UnlimitedCost:   r.UnlimitedCost,
+		State:           r.State,
+	}
+}
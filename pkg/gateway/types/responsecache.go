@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// LLMResponseCacheEntry is a response of a model that the LLM proxy returns for identical requests until it expires.
+type LLMResponseCacheEntry struct {
+	Hash        string `gorm:"primaryKey"`
+	CreatedAt   time.Time
+	ExpiresAt   time.Time `gorm:"index"`
+	Model       string
+	ContentType string
+	Body        []byte
+}
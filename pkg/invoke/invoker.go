@@ -189,6 +189,7 @@ type Options struct {
 	UserUID               string
 	GenerateName          string
 	ExtraEnv              []string
+	Timeout               time.Duration
 }
 
 func (i *Invoker) getChatState(ctx context.Context, c kclient.Client, run *v1.Run) (result string, _ error) {
@@ -392,6 +393,7 @@ func (i *Invoker) Agent(ctx context.Context, c kclient.WithWatch, agent *v1.Agen
 		PreviousRunName:       opt.PreviousRunName,
 		ForceNoResume:         opt.ForceNoResume,
 		GenerateName:          opt.GenerateName,
+		Timeout:               opt.Timeout,
 	})
 }
 
@@ -562,6 +564,10 @@ func (i *Invoker) Resume(ctx context.Context, c kclient.WithWatch, thread *v1.Th
 		}
 	}
 
+	if run.Spec.WaitFor != nil {
+		return i.waitFor(ctx, c, thread, run)
+	}
+
 	input := run.Spec.Input
 	if run.Status.State == v1.Waiting {
 		if run.Status.ExternalCall == nil {
@@ -613,10 +619,17 @@ func (i *Invoker) Resume(ctx context.Context, c kclient.WithWatch, thread *v1.Th
 		// Note: AuthenticatedGroup is added by default in the token service
 	}
 
+	// The project is recorded with the LLM usage of the run, so that its cost can be reported by project.
+	projectThreadName := thread.Spec.ParentThreadName
+	if projectThreadName == "" && thread.Spec.Project {
+		projectThreadName = thread.Name
+	}
+
 	token, err := i.tokenService.NewToken(jwt.TokenContext{
 		Namespace:      run.Namespace,
 		RunID:          run.Name,
 		ThreadID:       thread.Name,
+		ProjectID:      strings.Replace(projectThreadName, system.ThreadPrefix, system.ProjectPrefix, 1),
 		AgentID:        run.Spec.AgentName,
 		WorkflowID:     run.Spec.WorkflowName,
 		WorkflowStepID: run.Spec.WorkflowStepID,
@@ -929,6 +942,61 @@ func (i *Invoker) doSaveState(ctx context.Context, c kclient.Client, thread *v1.
 	return nil
 }
 
+// waitFor moves a run that waits for an external call to the waiting state, and completes it with the result of the
+// call once the result is added to the run. The model is not run; the conversation of the previous run is saved as the
+// conversation of this run, so that the runs after it continue from there.
+func (i *Invoker) waitFor(ctx context.Context, c kclient.Client, thread *v1.Thread, run *v1.Run) error {
+	if run.Status.ExternalCall == nil {
+		run.Status.State = v1.Waiting
+		run.Status.ExternalCall = run.Spec.WaitFor
+		return c.Status().Update(ctx, run)
+	}
+
+	var result *v1.ExternalCallResult
+	for _, r := range run.Spec.ExternalCallResults {
+		if r.ID == run.Spec.WaitFor.ID {
+			result = &r
+			break
+		}
+	}
+	if result == nil {
+		// Still waiting for the result
+		return nil
+	}
+
+	runState := &gtypes.RunState{
+		Name:       run.Name,
+		Namespace:  run.Namespace,
+		UserID:     thread.Spec.UserID,
+		ThreadName: run.Spec.ThreadName,
+		Done:       true,
+	}
+	if run.Spec.PreviousRunName != "" {
+		previousRunState, err := i.gatewayClient.RunState(ctx, run.Namespace, run.Spec.PreviousRunName)
+		if err != nil && !apierror.IsNotFound(err) {
+			return err
+		} else if err == nil {
+			runState.ChatState = previousRunState.ChatState
+		}
+	}
+
+	output, err := gz.Compress(result.Data)
+	if err != nil {
+		return err
+	}
+	runState.Output = output
+
+	if err := i.gatewayClient.CreateRunState(ctx, runState); err != nil && !apierror.IsAlreadyExists(err) {
+		return err
+	}
+
+	run.Status.State = v1.Continue
+	run.Status.ExternalCall = nil
+	run.Status.Output = result.Data
+	run.Status.EndTime = metav1.Now()
+	return c.Status().Update(ctx, run)
+}
+
 func toExternalCall(output string) *v1.ExternalCall {
 	var call v1.ExternalCall
 	if err := json.Unmarshal([]byte(strings.TrimSpace(output)), &call); err != nil || call.Type != "obotExternalCall" || call.ID == "" {
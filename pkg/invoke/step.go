@@ -2,29 +2,32 @@ package invoke
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/obot-platform/nah/pkg/router"
+	"github.com/obot-platform/obot/apiclient/types"
 	v1 "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1"
+	"github.com/obot-platform/obot/pkg/system"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type StepOptions struct {
 	PreviousRunName string
+	Timeout         time.Duration
+	// Input is used as the prompt instead of the step itself, such as when asking the model to correct its response.
+	Input string
 }
 
 func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.WorkflowStep, opt StepOptions) (*Response, error) {
-	input, err := i.getInput(step)
-	if err != nil {
-		return nil, err
+	input := opt.Input
+	if input == "" {
+		input = getInput(step)
 	}
 
-	var wfe v1.WorkflowExecution
-	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
-		return nil, err
-	}
-
-	var thread v1.Thread
-	if err := c.Get(ctx, router.Key(step.Namespace, wfe.Status.ThreadName), &thread); err != nil {
+	wfe, thread, err := stepThread(ctx, c, step)
+	if err != nil {
 		return nil, err
 	}
 
@@ -33,7 +36,7 @@ func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.Workfl
 		extraEnv = []string{"OBOT_TASK_BREAD_CRUMB=" + wfe.Spec.TaskBreakCrumb}
 	}
 
-	return i.Thread(ctx, c, &thread, input, Options{
+	return i.Thread(ctx, c, thread, input, Options{
 		WorkflowName:          wfe.Spec.WorkflowName,
 		WorkflowStepName:      step.Name,
 		WorkflowStepID:        step.Spec.Step.ID,
@@ -41,9 +44,77 @@ func (i *Invoker) Step(ctx context.Context, c kclient.WithWatch, step *v1.Workfl
 		PreviousRunName:       opt.PreviousRunName,
 		ForceNoResume:         opt.PreviousRunName == "",
 		ExtraEnv:              extraEnv,
+		Timeout:               opt.Timeout,
 	})
 }
 
-func (i *Invoker) getInput(step *v1.WorkflowStep) (string, error) {
-	return step.Spec.Step.Step, nil
+// StepWait starts a run for the step that waits for the result of an external call, such as the decision of an
+// approval, instead of running the model. Once the result is added to the run, the run completes with the result as
+// its output, and the conversation of the previous run is passed through to the runs after it.
+func (i *Invoker) StepWait(ctx context.Context, c kclient.WithWatch, step *v1.WorkflowStep, opt StepOptions, call v1.ExternalCall) (*Response, error) {
+	wfe, thread, err := stepThread(ctx, c, step)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unAbortThread(ctx, c, thread); err != nil {
+		return nil, err
+	}
+
+	run := v1.Run{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: system.RunPrefix,
+			Namespace:    thread.Namespace,
+			Finalizers:   []string{v1.RunFinalizer},
+		},
+		Spec: v1.RunSpec{
+			ThreadName:            thread.Name,
+			WorkflowName:          wfe.Spec.WorkflowName,
+			WorkflowExecutionName: wfe.Name,
+			WorkflowStepName:      step.Name,
+			WorkflowStepID:        step.Spec.Step.ID,
+			PreviousRunName:       opt.PreviousRunName,
+			Input:                 call.Data,
+			WaitFor:               &call,
+		},
+	}
+	if err := c.Create(ctx, &run); err != nil {
+		return nil, err
+	}
+
+	noEvents := make(chan types.Progress)
+	close(noEvents)
+	return &Response{
+		Run:    &run,
+		Thread: thread,
+		Events: noEvents,
+		cancel: func() {},
+	}, nil
+}
+
+// stepThread returns the workflow execution of the step and the thread that the step runs on.
+func stepThread(ctx context.Context, c kclient.Client, step *v1.WorkflowStep) (*v1.WorkflowExecution, *v1.Thread, error) {
+	var wfe v1.WorkflowExecution
+	if err := c.Get(ctx, router.Key(step.Namespace, step.Spec.WorkflowExecutionName), &wfe); err != nil {
+		return nil, nil, err
+	}
+
+	threadName := wfe.Status.ThreadName
+	if step.Spec.ThreadName != "" {
+		threadName = step.Spec.ThreadName
+	}
+
+	var thread v1.Thread
+	if err := c.Get(ctx, router.Key(step.Namespace, threadName), &thread); err != nil {
+		return nil, nil, err
+	}
+
+	return &wfe, &thread, nil
+}
+
+func getInput(step *v1.WorkflowStep) string {
+	if step.Spec.Step.OutputSchema == "" {
+		return step.Spec.Step.Step
+	}
+	return fmt.Sprintf("%s\n\nRespond with only JSON that matches the following JSON Schema:\n%s", step.Spec.Step.Step, step.Spec.Step.OutputSchema)
 }
@@ -26,6 +26,7 @@ type TokenContext struct {
 	Namespace      string
 	RunID          string
 	ThreadID       string
+	ProjectID      string
 	AgentID        string
 	WorkflowID     string
 	WorkflowStepID string
@@ -82,6 +83,7 @@ func (t *TokenService) DecodeToken(token string) (*TokenContext, error) {
 		Namespace:      claims["Namespace"].(string),
 		RunID:          claims["RunID"].(string),
 		ThreadID:       claims["ThreadID"].(string),
+		ProjectID:      claims["ProjectID"].(string),
 		AgentID:        claims["AgentID"].(string),
 		Scope:          claims["Scope"].(string),
 		WorkflowID:     claims["WorkflowID"].(string),
@@ -100,6 +102,7 @@ func (t *TokenService) NewToken(context TokenContext) (string, error) {
 		"Namespace":      context.Namespace,
 		"RunID":          context.RunID,
 		"ThreadID":       context.ThreadID,
+		"ProjectID":      context.ProjectID,
 		"AgentID":        context.AgentID,
 		"Scope":          context.Scope,
 		"WorkflowID":     context.WorkflowID,
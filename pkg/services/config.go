@@ -76,6 +76,13 @@ type Config struct {
 	HelperModel                string   `usage:"The model used to generate names and descriptions" default:"gpt-4.1-mini"`
 	EmailServerName            string   `usage:"The name of the email server to display for email receivers"`
 	EnableSMTPServer           bool     `usage:"Enable SMTP server to receive emails" default:"false" env:"OBOT_ENABLE_SMTP_SERVER"`
+	SMTPMaxMessageSize         int      `usage:"The maximum size in bytes of emails received by the SMTP server" default:"26214400"` // default is 25 MB
+	SMTPTLSCertFile            string   `usage:"The TLS certificate file used by the SMTP server for STARTTLS"`
+	SMTPTLSKeyFile             string   `usage:"The TLS key file used by the SMTP server for STARTTLS"`
+	SMTPTLSRequired            bool     `usage:"Require clients of the SMTP server to use STARTTLS" default:"false"`
+	SMTPRelayAddress           string   `usage:"The host:port of the SMTP relay used to send replies to emails"`
+	SMTPRelayUsername          string   `usage:"The username for the SMTP relay"`
+	SMTPRelayPassword          string   `usage:"The password for the SMTP relay"`
 	Docker                     bool     `usage:"Enable Docker support" default:"false" env:"OBOT_DOCKER"`
 	EnvKeys                    []string `usage:"The environment keys to pass through to the GPTScript server" env:"OBOT_ENV_KEYS"`
 	KnowledgeSetIngestionLimit int      `usage:"The maximum number of files to ingest into a knowledge set" default:"3000" name:"knowledge-set-ingestion-limit"`
@@ -106,6 +113,7 @@ type Services struct {
 	WorkspaceProviderType      string
 	ServerURL                  string
 	EmailServerName            string
+	EmailSender                *smtp.Sender
 	DevUIPort                  int
 	UserUIPort                 int
 	Events                     *events.Emitter
@@ -436,7 +444,20 @@ func New(ctx context.Context, config Config) (*Services, error) {
 	}
 
 	if config.EmailServerName != "" && config.EnableSMTPServer {
-		go smtp.Start(ctx, storageClient, config.EmailServerName)
+		go smtp.Start(ctx, storageClient, invoker, gptscriptClient, providerDispatcher, config.WorkspaceProviderType, config.EmailServerName, smtp.Options{
+			MaxMessageSize: config.SMTPMaxMessageSize,
+			TLSCertFile:    config.SMTPTLSCertFile,
+			TLSKeyFile:     config.SMTPTLSKeyFile,
+			TLSRequired:    config.SMTPTLSRequired,
+		})
+	}
+
+	var emailSender *smtp.Sender
+	if config.EmailServerName != "" && config.SMTPRelayAddress != "" {
+		emailSender, err = smtp.NewSender(config.SMTPRelayAddress, config.SMTPRelayUsername, config.SMTPRelayPassword)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	var geminiClient *gemini.Client
@@ -503,6 +524,7 @@ func New(ctx context.Context, config Config) (*Services, error) {
 		GatewayClient:              gatewayClient,
 		KnowledgeSetIngestionLimit: config.KnowledgeSetIngestionLimit,
 		EmailServerName:            config.EmailServerName,
+		EmailSender:                emailSender,
 		SupportDocker:              config.Docker,
 		AuthEnabled:                config.EnableAuthentication,
 		SendgridWebhookUsername:    config.SendgridWebhookUsername,
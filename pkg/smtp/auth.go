@@ -0,0 +1,176 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"strings"
+	"time"
+
+	"blitiri.com.ar/go/spf"
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/emersion/go-msgauth/dmarc"
+	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"golang.org/x/net/publicsuffix"
+)
+
+const authenticationTimeout = 10 * time.Second
+
+// resolver looks up the DNS records used to authenticate senders.
+var resolver spf.DNSResolver = net.DefaultResolver
+
+// authenticate checks the SPF record of the domain of the envelope sender and the DKIM signatures of the message,
+// and whether either of them passes for a domain that is aligned with the domain of the From header, as DMARC
+// requires.
+func authenticate(ctx context.Context, remoteAddr net.Addr, envelopeFrom string, message *mail.Message, data []byte) *emailtrigger.Authentication {
+	ctx, cancel := context.WithTimeout(ctx, authenticationTimeout)
+	defer cancel()
+
+	result := &emailtrigger.Authentication{
+		SPF:   string(spf.None),
+		DKIM:  "none",
+		DMARC: "fail",
+	}
+
+	if from, err := message.Header.AddressList("From"); err == nil && len(from) == 1 {
+		result.From = from[0].Address
+	}
+	fromDomain := domainOf(result.From)
+	if fromDomain == "" {
+		// DMARC requires exactly one From address.
+		return result
+	}
+
+	if ip := remoteIP(remoteAddr); ip != nil {
+		spfResult, _ := spf.CheckHostWithSender(ip, helo(message), envelopeFrom, spf.WithContext(ctx), spf.WithResolver(resolver))
+		result.SPF = string(spfResult)
+	}
+
+	var dkimDomains []string
+	verifications, err := dkim.VerifyWithOptions(bytes.NewReader(data), &dkim.VerifyOptions{
+		LookupTXT: func(domain string) ([]string, error) {
+			return resolver.LookupTXT(ctx, domain)
+		},
+		MaxVerifications: 5,
+	})
+	if err == nil || errors.Is(err, dkim.ErrTooManySignatures) {
+		for _, verification := range verifications {
+			if verification.Err == nil {
+				dkimDomains = append(dkimDomains, verification.Domain)
+			}
+		}
+		if len(dkimDomains) > 0 {
+			result.DKIM = "pass"
+		} else if len(verifications) > 0 {
+			result.DKIM = "fail"
+		}
+	}
+
+	CheckDMARC(ctx, result, envelopeFrom, dkimDomains)
+	return result
+}
+
+// CheckDMARC sets the DMARC result of an email from the results of its other checks: the SPF result for the
+// envelope sender, and the domains of the valid DKIM signatures. DMARC passes if either passes for a domain that is
+// aligned with the domain of the From address, in the way that the DMARC record of that domain requires.
+func CheckDMARC(ctx context.Context, result *emailtrigger.Authentication, envelopeFrom string, dkimDomains []string) {
+	ctx, cancel := context.WithTimeout(ctx, authenticationTimeout)
+	defer cancel()
+
+	result.DMARC = "fail"
+	fromDomain := domainOf(result.From)
+	if fromDomain == "" {
+		return
+	}
+
+	record, err := lookupDMARC(ctx, fromDomain)
+	if err != nil && !errors.Is(err, dmarc.ErrNoPolicy) {
+		result.DMARC = "temperror"
+		return
+	}
+
+	spfAlignment, dkimAlignment := dmarc.AlignmentMode(dmarc.AlignmentRelaxed), dmarc.AlignmentMode(dmarc.AlignmentRelaxed)
+	if record != nil {
+		result.DMARCPolicy = string(record.Policy)
+		if record.SPFAlignment != "" {
+			spfAlignment = record.SPFAlignment
+		}
+		if record.DKIMAlignment != "" {
+			dkimAlignment = record.DKIMAlignment
+		}
+	}
+
+	if result.SPF == string(spf.Pass) && aligned(fromDomain, domainOf(envelopeFrom), spfAlignment) {
+		result.DMARC = "pass"
+	}
+	for _, domain := range dkimDomains {
+		if aligned(fromDomain, domain, dkimAlignment) {
+			result.DMARC = "pass"
+		}
+	}
+}
+
+// lookupDMARC returns the DMARC record of the domain, or of its organizational domain if it does not have one.
+func lookupDMARC(ctx context.Context, domain string) (*dmarc.Record, error) {
+	opts := &dmarc.LookupOptions{
+		LookupTXT: func(domain string) ([]string, error) {
+			return resolver.LookupTXT(ctx, domain)
+		},
+	}
+
+	record, err := dmarc.LookupWithOptions(domain, opts)
+	if errors.Is(err, dmarc.ErrNoPolicy) {
+		if orgDomain := organizationalDomain(domain); orgDomain != domain {
+			return dmarc.LookupWithOptions(orgDomain, opts)
+		}
+	}
+	return record, err
+}
+
+func aligned(fromDomain, domain string, mode dmarc.AlignmentMode) bool {
+	if domain == "" {
+		return false
+	}
+	if strings.EqualFold(fromDomain, domain) {
+		return true
+	}
+	return mode != dmarc.AlignmentStrict && organizationalDomain(fromDomain) == organizationalDomain(domain)
+}
+
+func organizationalDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	if orgDomain, err := publicsuffix.EffectiveTLDPlusOne(domain); err == nil {
+		return orgDomain
+	}
+	return domain
+}
+
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return strings.ToLower(address[i+1:])
+	}
+	return ""
+}
+
+func remoteIP(addr net.Addr) net.IP {
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		return tcpAddr.IP
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
+// helo returns the name the client gave in HELO or EHLO, from the Received header added by the server.
+func helo(message *mail.Message) string {
+	received := message.Header.Get("Received")
+	if name, ok := strings.CutPrefix(received, "from "); ok {
+		name, _, _ = strings.Cut(name, " ")
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,344 @@
+package smtp
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/emersion/go-msgauth/dmarc"
+	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeResolver answers TXT lookups from a map and finds no other records.
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if txt, ok := f[strings.TrimSuffix(name, ".")]; ok {
+		return txt, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (f fakeResolver) LookupMX(_ context.Context, name string) ([]*net.MX, error) {
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (f fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func (f fakeResolver) LookupAddr(_ context.Context, addr string) ([]string, error) {
+	return nil, &net.DNSError{Err: "no such host", Name: addr, IsNotFound: true}
+}
+
+func TestAuthenticate(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	dkimRecord := "v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(publicKey)
+
+	remoteAddr := &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 25}
+
+	tests := []struct {
+		name         string
+		from         string
+		envelopeFrom string
+		dkimDomain   string
+		records      fakeResolver
+		wantSPF      string
+		wantDKIM     string
+		wantDMARC    string
+		wantPolicy   string
+	}{
+		{
+			name:         "spf for the from domain",
+			from:         "alice@example.com",
+			envelopeFrom: "alice@example.com",
+			records:      fakeResolver{"example.com": {"v=spf1 ip4:192.0.2.1 -all"}},
+			wantSPF:      "pass",
+			wantDKIM:     "none",
+			wantDMARC:    "pass",
+		},
+		{
+			name:         "spf for a subdomain with relaxed alignment",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@bounce.example.com",
+			records: fakeResolver{
+				"bounce.example.com": {"v=spf1 ip4:192.0.2.1 -all"},
+				"_dmarc.example.com": {"v=DMARC1; p=reject"},
+			},
+			wantSPF:    "pass",
+			wantDKIM:   "none",
+			wantDMARC:  "pass",
+			wantPolicy: "reject",
+		},
+		{
+			name:         "spf for a subdomain with strict alignment",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@bounce.example.com",
+			records: fakeResolver{
+				"bounce.example.com": {"v=spf1 ip4:192.0.2.1 -all"},
+				"_dmarc.example.com": {"v=DMARC1; p=quarantine; aspf=s"},
+			},
+			wantSPF:    "pass",
+			wantDKIM:   "none",
+			wantDMARC:  "fail",
+			wantPolicy: "quarantine",
+		},
+		{
+			name:         "strict alignment from the record of the organizational domain",
+			from:         "alice@mail.example.com",
+			envelopeFrom: "bounce@example.com",
+			records: fakeResolver{
+				"example.com":        {"v=spf1 ip4:192.0.2.1 -all"},
+				"_dmarc.example.com": {"v=DMARC1; p=reject; aspf=s"},
+			},
+			wantSPF:    "pass",
+			wantDKIM:   "none",
+			wantDMARC:  "fail",
+			wantPolicy: "reject",
+		},
+		{
+			name:         "spf for another domain",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@example.net",
+			records:      fakeResolver{"example.net": {"v=spf1 ip4:192.0.2.1 -all"}},
+			wantSPF:      "pass",
+			wantDKIM:     "none",
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "spf fails",
+			from:         "alice@example.com",
+			envelopeFrom: "alice@example.com",
+			records:      fakeResolver{"example.com": {"v=spf1 ip4:198.51.100.1 -all"}},
+			wantSPF:      "fail",
+			wantDKIM:     "none",
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "spf for a sibling under a public suffix",
+			from:         "alice@alice.github.io",
+			envelopeFrom: "mallory@mallory.github.io",
+			records:      fakeResolver{"mallory.github.io": {"v=spf1 ip4:192.0.2.1 -all"}},
+			wantSPF:      "pass",
+			wantDKIM:     "none",
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "dkim for a subdomain with relaxed alignment",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@example.net",
+			dkimDomain:   "mail.example.com",
+			records:      fakeResolver{"test._domainkey.mail.example.com": {dkimRecord}},
+			wantSPF:      "none",
+			wantDKIM:     "pass",
+			wantDMARC:    "pass",
+		},
+		{
+			name:         "dkim for a subdomain with strict alignment",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@example.net",
+			dkimDomain:   "mail.example.com",
+			records: fakeResolver{
+				"test._domainkey.mail.example.com": {dkimRecord},
+				"_dmarc.example.com":               {"v=DMARC1; p=none; adkim=s"},
+			},
+			wantSPF:    "none",
+			wantDKIM:   "pass",
+			wantDMARC:  "fail",
+			wantPolicy: "none",
+		},
+		{
+			name:         "dkim for a sibling under a public suffix",
+			from:         "alice@example.co.uk",
+			envelopeFrom: "bounce@example.net",
+			dkimDomain:   "other.co.uk",
+			records:      fakeResolver{"test._domainkey.other.co.uk": {dkimRecord}},
+			wantSPF:      "none",
+			wantDKIM:     "pass",
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "dkim key not found",
+			from:         "alice@example.com",
+			envelopeFrom: "bounce@example.net",
+			dkimDomain:   "example.com",
+			records:      fakeResolver{},
+			wantSPF:      "none",
+			wantDKIM:     "fail",
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "more than one from address",
+			from:         "alice@example.com, bob@example.com",
+			envelopeFrom: "alice@example.com",
+			records:      fakeResolver{"example.com": {"v=spf1 ip4:192.0.2.1 -all"}},
+			wantSPF:      "none",
+			wantDKIM:     "none",
+			wantDMARC:    "fail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := resolver
+			resolver = tt.records
+			t.Cleanup(func() { resolver = previous })
+
+			data := []byte("From: " + tt.from + "\r\nTo: task@obot.example\r\nSubject: Hello\r\n\r\nHello\r\n")
+			if tt.dkimDomain != "" {
+				var signed bytes.Buffer
+				require.NoError(t, dkim.Sign(&signed, bytes.NewReader(data), &dkim.SignOptions{
+					Domain:   tt.dkimDomain,
+					Selector: "test",
+					Signer:   privateKey,
+				}))
+				data = signed.Bytes()
+			}
+
+			message, err := mail.ReadMessage(bytes.NewReader(data))
+			require.NoError(t, err)
+
+			result := authenticate(context.Background(), remoteAddr, tt.envelopeFrom, message, data)
+			require.Equal(t, tt.wantSPF, result.SPF)
+			require.Equal(t, tt.wantDKIM, result.DKIM)
+			require.Equal(t, tt.wantDMARC, result.DMARC)
+			require.Equal(t, tt.wantPolicy, result.DMARCPolicy)
+		})
+	}
+}
+
+func TestCheckDMARC(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         string
+		spf          string
+		envelopeFrom string
+		dkimDomains  []string
+		records      fakeResolver
+		wantDMARC    string
+		wantPolicy   string
+	}{
+		{
+			name:         "spf aligned",
+			from:         "alice@example.com",
+			spf:          "pass",
+			envelopeFrom: "bounce@mail.example.com",
+			records:      fakeResolver{"_dmarc.example.com": {"v=DMARC1; p=reject"}},
+			wantDMARC:    "pass",
+			wantPolicy:   "reject",
+		},
+		{
+			name:         "spf passes for another domain",
+			from:         "alice@example.com",
+			spf:          "pass",
+			envelopeFrom: "bounce@sendgrid.net",
+			records:      fakeResolver{},
+			wantDMARC:    "fail",
+		},
+		{
+			name:         "dkim aligned",
+			from:         "alice@example.com",
+			spf:          "softfail",
+			envelopeFrom: "bounce@sendgrid.net",
+			dkimDomains:  []string{"sendgrid.net", "example.com"},
+			records:      fakeResolver{},
+			wantDMARC:    "pass",
+		},
+		{
+			name:         "dkim not aligned with strict alignment",
+			from:         "alice@example.com",
+			spf:          "none",
+			envelopeFrom: "bounce@sendgrid.net",
+			dkimDomains:  []string{"mail.example.com"},
+			records:      fakeResolver{"_dmarc.example.com": {"v=DMARC1; p=quarantine; adkim=s"}},
+			wantDMARC:    "fail",
+			wantPolicy:   "quarantine",
+		},
+		{
+			name:         "no from address",
+			spf:          "pass",
+			envelopeFrom: "alice@example.com",
+			dkimDomains:  []string{"example.com"},
+			records:      fakeResolver{},
+			wantDMARC:    "fail",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous := resolver
+			resolver = tt.records
+			t.Cleanup(func() { resolver = previous })
+
+			result := &emailtrigger.Authentication{From: tt.from, SPF: tt.spf}
+			CheckDMARC(context.Background(), result, tt.envelopeFrom, tt.dkimDomains)
+			require.Equal(t, tt.wantDMARC, result.DMARC)
+			require.Equal(t, tt.wantPolicy, result.DMARCPolicy)
+		})
+	}
+}
+
+func TestAligned(t *testing.T) {
+	tests := []struct {
+		name       string
+		fromDomain string
+		domain     string
+		mode       dmarc.AlignmentMode
+		want       bool
+	}{
+		{name: "same domain relaxed", fromDomain: "example.com", domain: "example.com", mode: dmarc.AlignmentRelaxed, want: true},
+		{name: "same domain strict", fromDomain: "example.com", domain: "example.com", mode: dmarc.AlignmentStrict, want: true},
+		{name: "different case", fromDomain: "Example.COM", domain: "example.com", mode: dmarc.AlignmentStrict, want: true},
+		{name: "subdomain relaxed", fromDomain: "example.com", domain: "mail.example.com", mode: dmarc.AlignmentRelaxed, want: true},
+		{name: "subdomain strict", fromDomain: "example.com", domain: "mail.example.com", mode: dmarc.AlignmentStrict, want: false},
+		{name: "sibling subdomains relaxed", fromDomain: "a.example.com", domain: "b.example.com", mode: dmarc.AlignmentRelaxed, want: true},
+		{name: "sibling subdomains strict", fromDomain: "a.example.com", domain: "b.example.com", mode: dmarc.AlignmentStrict, want: false},
+		{name: "unset mode is relaxed", fromDomain: "example.com", domain: "mail.example.com", want: true},
+		{name: "different domains", fromDomain: "example.com", domain: "example.net", mode: dmarc.AlignmentRelaxed, want: false},
+		{name: "lookalike domain", fromDomain: "example.com", domain: "notexample.com", mode: dmarc.AlignmentRelaxed, want: false},
+		{name: "multi-label public suffix", fromDomain: "shop.example.co.uk", domain: "mail.example.co.uk", mode: dmarc.AlignmentRelaxed, want: true},
+		{name: "siblings under a multi-label public suffix", fromDomain: "example.co.uk", domain: "other.co.uk", mode: dmarc.AlignmentRelaxed, want: false},
+		{name: "siblings under a private public suffix", fromDomain: "alice.github.io", domain: "mallory.github.io", mode: dmarc.AlignmentRelaxed, want: false},
+		{name: "public suffix itself", fromDomain: "example.co.uk", domain: "co.uk", mode: dmarc.AlignmentRelaxed, want: false},
+		{name: "empty domain", fromDomain: "example.com", domain: "", mode: dmarc.AlignmentRelaxed, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, aligned(tt.fromDomain, tt.domain, tt.mode))
+		})
+	}
+}
+
+func TestOrganizationalDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{domain: "example.com", want: "example.com"},
+		{domain: "mail.example.com", want: "example.com"},
+		{domain: "a.b.mail.example.com", want: "example.com"},
+		{domain: "Mail.Example.COM.", want: "example.com"},
+		{domain: "mail.example.co.uk", want: "example.co.uk"},
+		{domain: "alice.github.io", want: "alice.github.io"},
+		{domain: "www.alice.github.io", want: "alice.github.io"},
+		{domain: "co.uk", want: "co.uk"},
+		{domain: "com", want: "com"},
+		{domain: "localhost", want: "localhost"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.domain, func(t *testing.T) {
+			require.Equal(t, tt.want, organizationalDomain(tt.domain))
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package smtp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	netsmtp "net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Sender sends email through an SMTP relay.
+type Sender struct {
+	addr string
+	auth netsmtp.Auth
+}
+
+// NewSender returns a Sender for the relay at addr, in the form host:port. The username and password are optional.
+func NewSender(addr, username, password string) (*Sender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP relay address %q: %w", addr, err)
+	}
+
+	s := &Sender{
+		addr: addr,
+	}
+	if username != "" {
+		s.auth = netsmtp.PlainAuth("", username, password, host)
+	}
+	return s, nil
+}
+
+type Message struct {
+	From      string
+	To        string
+	Subject   string
+	MessageID string
+	// InReplyTo and References are the message IDs of the email that this message replies to and of the emails
+	// before it in the same thread.
+	InReplyTo  string
+	References []string
+	Body       string
+}
+
+func (s *Sender) Send(msg Message) error {
+	data, err := msg.bytes()
+	if err != nil {
+		return err
+	}
+	return netsmtp.SendMail(s.addr, s.auth, msg.From, []string{msg.To}, data)
+}
+
+// Permanent returns whether the error of Send is a permanent rejection by the relay, such as an unknown recipient,
+// which fails again if the message is sent again.
+func Permanent(err error) bool {
+	var protoErr *textproto.Error
+	return errors.As(err, &protoErr) && protoErr.Code >= 500
+}
+
+func (m Message) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+		}
+	}
+
+	header("From", m.From)
+	header("To", m.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", m.MessageID)
+	header("In-Reply-To", m.InReplyTo)
+	header("References", strings.Join(m.References, " "))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `text/plain; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(m.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -11,11 +11,16 @@ import (
 	"mime/multipart"
 	"net"
 	"net/mail"
+	"net/textproto"
 	"strings"
+	"sync"
 
+	"github.com/gptscript-ai/go-gptscript"
 	"github.com/mhale/smtpd"
 	"github.com/obot-platform/obot/logger"
 	"github.com/obot-platform/obot/pkg/emailtrigger"
+	"github.com/obot-platform/obot/pkg/gateway/server/dispatcher"
+	"github.com/obot-platform/obot/pkg/invoke"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,15 +32,32 @@ type Server struct {
 	emailTrigger *emailtrigger.EmailHandler
 }
 
-func Start(ctx context.Context, c kclient.Client, hostname string) {
-	emailTrigger := emailtrigger.EmailTrigger(c, hostname)
+type Options struct {
+	// MaxMessageSize is the maximum size of a message in bytes, including attachments.
+	MaxMessageSize int
+	// TLSCertFile and TLSKeyFile enable STARTTLS.
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSRequired rejects clients that do not use STARTTLS.
+	TLSRequired bool
+}
+
+func Start(ctx context.Context, c kclient.WithWatch, invoker *invoke.Invoker, gClient *gptscript.GPTScript, dispatcher *dispatcher.Dispatcher, workspaceProvider, hostname string, opts Options) {
+	emailTrigger := emailtrigger.EmailTrigger(c, invoker, gClient, dispatcher, workspaceProvider, hostname)
 	s := Server{
 		s: smtpd.Server{
-			Addr: ":2525",
+			Addr:    ":2525",
+			MaxSize: opts.MaxMessageSize,
 		},
 		ctx:          ctx,
 		emailTrigger: emailTrigger,
 	}
+	if opts.TLSCertFile != "" || opts.TLSKeyFile != "" {
+		if err := s.s.ConfigureTLS(opts.TLSCertFile, opts.TLSKeyFile); err != nil {
+			log.Fatalf("failed to configure smtp server TLS: %v", err)
+		}
+		s.s.TLSRequired = opts.TLSRequired
+	}
 	s.s.Handler = s.handler
 	go func() {
 		err := s.s.ListenAndServe()
@@ -47,7 +69,7 @@ func Start(ctx context.Context, c kclient.Client, hostname string) {
 	}()
 }
 
-func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) error {
+func (s *Server) handler(remoteAddr net.Addr, from string, to []string, data []byte) error {
 	log.Infof("New mail received from %s for %s: length=%d", from, to, len(data))
 
 	message, err := mail.ReadMessage(bytes.NewReader(data))
@@ -55,72 +77,125 @@ func (s *Server) handler(_ net.Addr, from string, to []string, data []byte) erro
 		return fmt.Errorf("read message: %w", err)
 	}
 
-	body, err := getBody(message)
-	if err != nil {
+	var email email
+	if err := email.read(textproto.MIMEHeader(message.Header), message.Body); err != nil {
 		return fmt.Errorf("get body: %w", err)
 	}
 
+	body := email.text
+	if body == "" {
+		body = email.html
+	}
+	if body == "" && len(email.attachments) == 0 {
+		return fmt.Errorf("failed to find text/plain body: %s", message.Header.Get("Content-Type"))
+	}
+
 	fromAddress, err := mail.ParseAddress(from)
 	if err != nil {
 		return fmt.Errorf("parse from address: %w", err)
 	}
 
-	return s.emailTrigger.Handler(s.ctx, fromAddress.Address, to, message.Header.Get("Subject"), []byte(body))
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	if err != nil {
+		subject = message.Header.Get("Subject")
+	}
+
+	return s.emailTrigger.Handler(s.ctx, emailtrigger.Email{
+		From:        fromAddress.Address,
+		To:          to,
+		ReplyTo:     emailtrigger.ReplyAddress(message.Header.Get("Reply-To"), message.Header.Get("From"), fromAddress.Address),
+		Subject:     subject,
+		Body:        body,
+		MessageID:   message.Header.Get("Message-ID"),
+		References:  emailtrigger.ParseMessageIDs(message.Header.Get("References"), message.Header.Get("In-Reply-To")),
+		Attachments: email.attachments,
+		Authenticate: sync.OnceValue(func() *emailtrigger.Authentication {
+			return authenticate(s.ctx, remoteAddr, from, message, data)
+		}),
+	})
 }
 
-func getBody(message *mail.Message) (string, error) {
-	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+// email is the body and attachments of a message.
+type email struct {
+	text, html  string
+	attachments []emailtrigger.Attachment
+}
+
+// read reads a part of a message, including all the parts nested in it if it is multipart. The first text/plain
+// part that is not an attachment is the body, or the first text/html part if there is no text/plain part.
+func (e *email) read(header textproto.MIMEHeader, body io.Reader) error {
+	contentType := header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "text/plain"
+	}
+	mediaType, params, err := mime.ParseMediaType(contentType)
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	var html string
 	if strings.HasPrefix(mediaType, "multipart/") {
-		mr := multipart.NewReader(message.Body, params["boundary"])
+		mr := multipart.NewReader(body, params["boundary"])
 		for {
 			p, err := mr.NextPart()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					// Break and return whatever html is found or an error.
-					break
-				}
-				return "", err
-			}
-			if strings.HasPrefix(p.Header.Get("Content-Type"), "text/plain") {
-				d, err := io.ReadAll(p)
-				if err != nil {
-					return "", err
-				}
-				if p.Header.Get("Content-Transfer-Encoding") == "base64" {
-					d, err = base64.StdEncoding.DecodeString(string(d))
-				}
-				return string(d), err
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
 			}
-			if strings.HasPrefix(p.Header.Get("Content-Type"), "text/html") {
-				d, err := io.ReadAll(p)
-				if err != nil {
-					return "", err
-				}
-				html = string(d)
+			if err := e.read(p.Header, p); err != nil {
+				return err
 			}
 		}
-	} else if strings.HasPrefix(mediaType, "text/plain") || strings.HasPrefix(mediaType, "text/html") {
-		d, err := io.ReadAll(message.Body)
+	}
+
+	filename := attachmentName(header, params)
+	isBody := filename == "" && (mediaType == "text/plain" && e.text == "" || mediaType == "text/html" && e.html == "")
+	if filename == "" && !isBody {
+		return nil
+	}
+
+	d, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(header.Get("Content-Transfer-Encoding"), "base64") {
+		d, err = base64.StdEncoding.DecodeString(string(d))
 		if err != nil {
-			return "", err
-		}
-		if message.Header.Get("Content-Transfer-Encoding") == "base64" {
-			d, err = base64.StdEncoding.DecodeString(string(d))
-			if err != nil {
-				return "", err
-			}
+			return err
 		}
-		html = string(d)
 	}
 
-	if html != "" {
-		return html, nil
+	switch {
+	case !isBody:
+		e.attachments = append(e.attachments, emailtrigger.Attachment{
+			Filename:    filename,
+			ContentType: mediaType,
+			Data:        d,
+		})
+	case mediaType == "text/plain":
+		e.text = string(d)
+	default:
+		e.html = string(d)
 	}
 
-	return "", fmt.Errorf("failed to find text/plain body: %s", mediaType)
+	return nil
+}
+
+// attachmentName returns the filename of a part that is an attachment, or an empty string if the part is not one.
+func attachmentName(header textproto.MIMEHeader, contentTypeParams map[string]string) string {
+	var filename string
+	disposition, dispositionParams, err := mime.ParseMediaType(header.Get("Content-Disposition"))
+	if err == nil {
+		filename = dispositionParams["filename"]
+	}
+	if filename == "" {
+		filename = contentTypeParams["name"]
+	}
+	if filename == "" && disposition == "attachment" {
+		filename = "attachment"
+	}
+	if decoded, err := new(mime.WordDecoder).DecodeHeader(filename); err == nil {
+		filename = decoded
+	}
+	return filename
 }
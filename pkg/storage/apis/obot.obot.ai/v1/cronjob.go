@@ -66,6 +66,10 @@ type CronJobSpec struct {
 type CronJobStatus struct {
 	LastRunStartedAt           *metav1.Time `json:"lastRunStartedAt,omitempty"`
 	LastSuccessfulRunCompleted *metav1.Time `json:"lastSuccessfulRunCompleted,omitempty"`
+	// LastScheduledTime is the tick of the schedule that was last run or skipped.
+	LastScheduledTime *metav1.Time `json:"lastScheduledTime,omitempty"`
+	// RunCount is the number of times the schedule has run.
+	RunCount int `json:"runCount,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
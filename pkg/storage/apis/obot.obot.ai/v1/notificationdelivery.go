@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*NotificationDelivery)(nil)
+	_ DeleteRefs    = (*NotificationDelivery)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationDelivery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NotificationDeliverySpec   `json:"spec,omitempty"`
+	Status NotificationDeliveryStatus `json:"status,omitempty"`
+}
+
+func (in *NotificationDelivery) FieldNames() []string {
+	return []string{"spec.threadName", "spec.workflowName"}
+}
+
+func (in *NotificationDelivery) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *NotificationDelivery) Get(field string) (value string) {
+	switch field {
+	case "spec.threadName":
+		return in.Spec.ThreadName
+	case "spec.workflowName":
+		return in.Spec.WorkflowName
+	}
+	return ""
+}
+
+func (*NotificationDelivery) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Task", "Spec.WorkflowName"},
+		{"Run", "Spec.WorkflowExecutionName"},
+		{"Sink", "Spec.Sink.Name"},
+		{"Event", "Spec.Event"},
+		{"State", "Status.State"},
+		{"Created", "{{ago .CreationTimestamp}}"},
+	}
+}
+
+func (in *NotificationDelivery) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &Thread{}, Name: in.Spec.ThreadName},
+		{ObjType: &Workflow{}, Name: in.Spec.WorkflowName},
+	}
+}
+
+type NotificationDeliverySpec struct {
+	// ThreadName is the project of the task.
+	ThreadName            string                  `json:"threadName,omitempty"`
+	WorkflowName          string                  `json:"workflowName,omitempty"`
+	WorkflowExecutionName string                  `json:"workflowExecutionName,omitempty"`
+	Event                 types.NotificationEvent `json:"event,omitempty"`
+	// Sink is a copy of the sink when the event happened, so that retries are sent to the same place.
+	Sink    types.NotificationSink    `json:"sink,omitempty"`
+	Payload types.NotificationPayload `json:"payload,omitempty"`
+}
+
+type NotificationDeliveryStatus struct {
+	State    types.NotificationDeliveryState `json:"state,omitempty"`
+	Attempts []NotificationAttempt           `json:"attempts,omitempty"`
+	// SentTo are the recipients of an email sink that were sent the notification.
+	SentTo []string `json:"sentTo,omitempty"`
+}
+
+type NotificationAttempt struct {
+	Time       metav1.Time `json:"time,omitempty"`
+	StatusCode int         `json:"statusCode,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NotificationDeliveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NotificationDelivery `json:"items"`
+}
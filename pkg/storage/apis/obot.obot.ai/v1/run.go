@@ -7,15 +7,16 @@ import (
 )
 
 const (
-	RunFinalizer             = "obot.obot.ai/run"
-	ThreadFinalizer          = "obot.obot.ai/thread"
-	KnowledgeFileFinalizer   = "obot.obot.ai/knowledge-file"
-	WorkspaceFinalizer       = "obot.obot.ai/workspace"
-	KnowledgeSetFinalizer    = "obot.obot.ai/knowledge-set"
-	KnowledgeSourceFinalizer = "obot.obot.ai/knowledge-source"
-	ToolReferenceFinalizer   = "obot.obot.ai/tool-reference"
-	AgentFinalizer           = "obot.obot.ai/agent"
-	WorkflowFinalizer        = "obot.obot.ai/workflow"
+	RunFinalizer               = "obot.obot.ai/run"
+	ThreadFinalizer            = "obot.obot.ai/thread"
+	KnowledgeFileFinalizer     = "obot.obot.ai/knowledge-file"
+	WorkspaceFinalizer         = "obot.obot.ai/workspace"
+	KnowledgeSetFinalizer      = "obot.obot.ai/knowledge-set"
+	KnowledgeSourceFinalizer   = "obot.obot.ai/knowledge-source"
+	ToolReferenceFinalizer     = "obot.obot.ai/tool-reference"
+	AgentFinalizer             = "obot.obot.ai/agent"
+	WorkflowFinalizer          = "obot.obot.ai/workflow"
+	WorkflowExecutionFinalizer = "obot.obot.ai/workflow-execution"
 
 	ModelProviderSyncAnnotation       = "obot.ai/model-provider-sync"
 	WorkflowSyncAnnotation            = "obot.ai/workflow-sync"
@@ -90,6 +91,9 @@ type RunSpec struct {
 	DefaultModel          string                  `json:"defaultModel,omitempty"`
 	Timeout               metav1.Duration         `json:"timeout,omitempty"`
 	ExternalCallResults   []ExternalCallResult    `json:"externalCallResults,omitempty"`
+	// WaitFor is an external call, such as an approval, that the run waits for instead of running a tool. The result
+	// of the call is the output of the run, and the conversation of the previous run is passed through unchanged.
+	WaitFor *ExternalCall `json:"waitFor,omitempty"`
 }
 
 type ExternalCallResult struct {
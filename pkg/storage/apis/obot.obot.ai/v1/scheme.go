@@ -61,6 +61,10 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&WorkspaceList{},
 		&Webhook{},
 		&WebhookList{},
+		&WebhookDelivery{},
+		&WebhookDeliveryList{},
+		&NotificationDelivery{},
+		&NotificationDeliveryList{},
 		&CronJob{},
 		&CronJobList{},
 		&OAuthApp{},
@@ -81,6 +85,10 @@ func AddToSchemeWithGV(scheme *runtime.Scheme, schemeGroupVersion schema.GroupVe
 		&SlackTriggerList{},
 		&SlackReceiver{},
 		&SlackReceiverList{},
+		&TeamsReceiver{},
+		&TeamsReceiverList{},
+		&TeamsMessage{},
+		&TeamsMessageList{},
 		&UserDelete{},
 		&UserDeleteList{},
 	); err != nil {
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*TeamsMessage)(nil)
+	_ DeleteRefs    = (*TeamsMessage)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TeamsMessage is a message from a Teams conversation that is run in the thread of the conversation and answered with
+// a reply. It is named after the ID of the activity, so that an activity that the Bot Framework sends again is only
+// run once.
+type TeamsMessage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeamsMessageSpec   `json:"spec,omitempty"`
+	Status TeamsMessageStatus `json:"status,omitempty"`
+}
+
+func (in *TeamsMessage) FieldNames() []string {
+	return []string{"spec.threadName", "spec.receiverName"}
+}
+
+func (in *TeamsMessage) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *TeamsMessage) Get(field string) (value string) {
+	switch field {
+	case "spec.threadName":
+		return in.Spec.ThreadName
+	case "spec.receiverName":
+		return in.Spec.ReceiverName
+	}
+	return ""
+}
+
+func (*TeamsMessage) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Receiver", "Spec.ReceiverName"},
+		{"Thread", "Spec.ThreadName"},
+		{"Run", "Status.RunName"},
+		{"Replied", "Status.Replied"},
+		{"Received", "{{ago .Spec.ReceivedAt}}"},
+	}
+}
+
+func (in *TeamsMessage) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &TeamsReceiver{}, Name: in.Spec.ReceiverName},
+		{ObjType: &Thread{}, Name: in.Spec.ThreadName},
+	}
+}
+
+type TeamsMessageSpec struct {
+	ReceiverName string `json:"receiverName,omitempty"`
+	// ThreadName is the thread of the conversation of the message.
+	ThreadName string      `json:"threadName,omitempty"`
+	ReceivedAt metav1.Time `json:"receivedAt,omitempty"`
+	// Input is the text that the thread is run with.
+	Input string `json:"input,omitempty"`
+	// Activity is the JSON of the message activity, which the reply is sent for.
+	Activity []byte `json:"activity,omitempty"`
+}
+
+type TeamsMessageStatus struct {
+	// RunName is the run of the thread for the message.
+	RunName string `json:"runName,omitempty"`
+	// Replied is whether the output of the run was sent as a reply to the message.
+	Replied bool `json:"replied,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type TeamsMessageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamsMessage `json:"items"`
+}
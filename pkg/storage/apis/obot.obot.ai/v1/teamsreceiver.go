@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type TeamsReceiver struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TeamsReceiverSpec   `json:"spec,omitempty"`
+	Status            TeamsReceiverStatus `json:"status,omitempty"`
+}
+
+type TeamsReceiverStatus struct {
+}
+
+func (r *TeamsReceiver) Has(field string) bool {
+	return r.Get(field) != ""
+}
+
+func (r *TeamsReceiver) Get(field string) string {
+	if r != nil {
+		switch field {
+		case "spec.threadName":
+			return r.Spec.ThreadName
+		case "spec.manifest.appID":
+			return r.Spec.Manifest.AppID
+		}
+	}
+
+	return ""
+}
+
+func (r *TeamsReceiver) FieldNames() []string {
+	return []string{"spec.threadName", "spec.manifest.appID"}
+}
+
+func (r *TeamsReceiver) DeleteRefs() []Ref {
+	return []Ref{
+		{
+			ObjType: &Thread{},
+			Name:    r.Spec.ThreadName,
+		},
+	}
+}
+
+type TeamsReceiverSpec struct {
+	Manifest   types.TeamsReceiverManifest `json:"manifest,omitempty"`
+	ThreadName string                      `json:"threadName,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type TeamsReceiverList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeamsReceiver `json:"items"`
+}
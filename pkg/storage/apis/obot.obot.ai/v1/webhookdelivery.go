@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/obot-platform/nah/pkg/fields"
+	"github.com/obot-platform/obot/apiclient/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	_ fields.Fields = (*WebhookDelivery)(nil)
+	_ DeleteRefs    = (*WebhookDelivery)(nil)
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WebhookDelivery struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebhookDeliverySpec   `json:"spec,omitempty"`
+	Status WebhookDeliveryStatus `json:"status,omitempty"`
+}
+
+func (in *WebhookDelivery) FieldNames() []string {
+	return []string{"spec.webhookName"}
+}
+
+func (in *WebhookDelivery) Has(field string) (exists bool) {
+	return slices.Contains(in.FieldNames(), field)
+}
+
+func (in *WebhookDelivery) Get(field string) (value string) {
+	switch field {
+	case "spec.webhookName":
+		return in.Spec.WebhookName
+	}
+	return ""
+}
+
+func (*WebhookDelivery) GetColumns() [][]string {
+	return [][]string{
+		{"Name", "Name"},
+		{"Webhook", "Spec.WebhookName"},
+		{"Delivery ID", "Spec.DeliveryID"},
+		{"Result", "Spec.Result"},
+		{"Received", "{{ago .Spec.ReceivedAt}}"},
+	}
+}
+
+func (in *WebhookDelivery) DeleteRefs() []Ref {
+	return []Ref{
+		{ObjType: &Webhook{}, Name: in.Spec.WebhookName},
+	}
+}
+
+type WebhookDeliverySpec struct {
+	WebhookName string      `json:"webhookName,omitempty"`
+	DeliveryID  string      `json:"deliveryID,omitempty"`
+	ReceivedAt  metav1.Time `json:"receivedAt,omitempty"`
+	// Headers and Body are those of the request, so that the delivery can be redelivered. The body of a request that
+	// was rejected is not saved.
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    []byte            `json:"body,omitempty"`
+	// BodyTruncated is whether only the start of the body was saved because it is large, in which case the delivery
+	// cannot be redelivered.
+	BodyTruncated bool                        `json:"bodyTruncated,omitempty"`
+	BodySize      int                         `json:"bodySize,omitempty"`
+	BodyHash      string                      `json:"bodyHash,omitempty"`
+	Result        types.WebhookDeliveryResult `json:"result,omitempty"`
+	// ResultKey is the secret key that is required to get the result of a delivery to a synchronous webhook.
+	ResultKey string `json:"resultKey,omitempty"`
+}
+
+type WebhookDeliveryStatus struct {
+	WorkflowExecutionNames []string `json:"workflowExecutionNames,omitempty"`
+	Duplicates             int      `json:"duplicates,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WebhookDeliveryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebhookDelivery `json:"items"`
+}
@@ -33,6 +33,10 @@ func (in *Workflow) Get(field string) (value string) {
 		return in.Spec.ThreadName
 	case "spec.slack":
 		return strconv.FormatBool(in.Spec.Manifest.OnSlackMessage != nil)
+	case "spec.onTaskCompletion":
+		if in.Spec.Manifest.OnTaskCompletion != nil {
+			return in.Spec.Manifest.OnTaskCompletion.TaskID
+		}
 	}
 	return ""
 }
@@ -41,6 +45,7 @@ func (in *Workflow) FieldNames() []string {
 	return []string{
 		"spec.threadName",
 		"spec.slack",
+		"spec.onTaskCompletion",
 	}
 }
 
@@ -60,6 +65,10 @@ func (in *Workflow) DeleteRefs() []Ref {
 }
 
 type WorkflowStatus struct {
+	// OnTaskCompletionTaskID and OnTaskCompletionSince record which task the task completion trigger of the workflow
+	// is for and since when. Only runs of that task that are done after then trigger the workflow.
+	OnTaskCompletionTaskID string       `json:"onTaskCompletionTaskID,omitempty"`
+	OnTaskCompletionSince  *metav1.Time `json:"onTaskCompletionSince,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
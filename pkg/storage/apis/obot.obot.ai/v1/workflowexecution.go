@@ -76,6 +76,28 @@ type WorkflowExecutionSpec struct {
 	// TaskBreadCrumb is a comma-delimited list of taskID calls made to execute this task.
 	// This helps to prevent cycles when tasks call tasks.
 	TaskBreakCrumb string `json:"taskBreakCrumb,omitempty"`
+	// Manifest is used instead of the manifest of the workflow, such as when a run is replayed with an edited task.
+	Manifest *types.WorkflowManifest `json:"manifest,omitempty"`
+	// ReplayOf is the name of the execution that this execution replays.
+	ReplayOf string `json:"replayOf,omitempty"`
+	// TriggeredBy is the name of the execution whose completion started this execution.
+	TriggeredBy string `json:"triggeredBy,omitempty"`
+	// AttachmentsWorkspaceID is the ID of a workspace with files, such as email attachments, that are copied into
+	// the workspace of the thread of this execution before it runs. The workspace is deleted once they are copied, or
+	// when the execution is deleted.
+	AttachmentsWorkspaceID string `json:"attachmentsWorkspaceID,omitempty"`
+	// EmailMessage is the email that started this execution, or that continued it, when the output of the
+	// execution is sent as a reply to it.
+	EmailMessage *EmailMessage `json:"emailMessage,omitempty"`
+}
+
+type EmailMessage struct {
+	MessageID string `json:"messageID,omitempty"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+	Subject   string `json:"subject,omitempty"`
+	// References are the message IDs of the emails before this one in the same thread.
+	References []string `json:"references,omitempty"`
 }
 
 func (in *WorkflowExecution) DeleteRefs() []Ref {
@@ -94,6 +116,24 @@ type WorkflowExecutionStatus struct {
 	WorkflowManifest   *types.WorkflowManifest `json:"workflowManifest,omitempty"`
 	EndTime            *metav1.Time            `json:"endTime,omitempty"`
 	WorkflowGeneration int64                   `json:"workflowGeneration,omitempty"`
+	// CopiedAttachmentsWorkspaceID is the ID of the attachments workspace whose files were copied to the thread.
+	CopiedAttachmentsWorkspaceID string `json:"copiedAttachmentsWorkspaceID,omitempty"`
+	// DeletedAttachmentsWorkspaceID is the ID of the last attachments workspace that was deleted.
+	DeletedAttachmentsWorkspaceID string `json:"deletedAttachmentsWorkspaceID,omitempty"`
+	// EmailReplyMessageID is the message ID of the last reply sent to the email of the execution.
+	EmailReplyMessageID string `json:"emailReplyMessageID,omitempty"`
+	// EmailReplyFailure is the failure to send the latest reply, if it has not been sent.
+	EmailReplyFailure *EmailReplyFailure `json:"emailReplyFailure,omitempty"`
+}
+
+type EmailReplyFailure struct {
+	// MessageID is the message ID of the reply that failed.
+	MessageID   string      `json:"messageID,omitempty"`
+	Attempts    int         `json:"attempts,omitempty"`
+	LastAttempt metav1.Time `json:"lastAttempt,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	// Final is whether the reply is not retried, because the relay rejected it permanently or the attempts ran out.
+	Final bool `json:"final,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
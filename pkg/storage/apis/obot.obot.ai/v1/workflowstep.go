@@ -49,6 +49,7 @@ func (in *WorkflowStep) GetColumns() [][]string {
 		{"After", "Spec.AfterWorkflowStepName"},
 		{"Runs", "{{ .Status.RunNames | arrayNoSpace }}"},
 		{"LastRun", "Status.LastRunName"},
+		{"Branch", "Status.Branch"},
 		{"StepID", "Spec.Step.ID"},
 		{"WFE", "Spec.WorkflowExecutionName"},
 		{"Created", "{{ago .CreationTimestamp}}"},
@@ -60,6 +61,9 @@ type WorkflowStepSpec struct {
 	Step                  types.Step `json:"step,omitempty"`
 	WorkflowExecutionName string     `json:"workflowExecutionName,omitempty"`
 	WorkflowGeneration    int64      `json:"workflowGeneration,omitempty"`
+	// ThreadName is the thread that the runs of the step use instead of the thread of the workflow execution, so that
+	// steps that run at the same time, such as the lanes of a parallel step, don't share a thread.
+	ThreadName string `json:"threadName,omitempty"`
 }
 
 func (in *WorkflowStep) DeleteRefs() []Ref {
@@ -81,6 +85,13 @@ type WorkflowStepStatus struct {
 	ThreadName         string              `json:"threadName,omitempty"`
 	RunNames           []string            `json:"runNames,omitempty"`
 	LastRunName        string              `json:"lastRunName,omitempty"`
+	// StructuredOutput is the output of a step that does not come from its last run, such as the JSON output of a
+	// step with an output schema after it was validated, or the output of a sub-task
+	StructuredOutput string `json:"structuredOutput,omitempty"`
+	// OutputRetries is the number of runs that were made to correct output that did not match the output schema
+	OutputRetries int `json:"outputRetries,omitempty"`
+	// Branch is the branch taken by an if or switch step
+	Branch string `json:"branch,omitempty"`
 }
 
 func (in WorkflowStepStatus) FirstRun() string {
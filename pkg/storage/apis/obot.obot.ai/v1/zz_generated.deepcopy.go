@@ -379,6 +379,10 @@ func (in *CronJobStatus) DeepCopyInto(out *CronJobStatus) {
 		in, out := &in.LastSuccessfulRunCompleted, &out.LastSuccessfulRunCompleted
 		*out = (*in).DeepCopy()
 	}
+	if in.LastScheduledTime != nil {
+		in, out := &in.LastScheduledTime, &out.LastScheduledTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobStatus.
@@ -396,7 +400,7 @@ func (in *DefaultModelAlias) DeepCopyInto(out *DefaultModelAlias) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 }
 
@@ -453,7 +457,7 @@ func (in *DefaultModelAliasList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DefaultModelAliasSpec) DeepCopyInto(out *DefaultModelAliasSpec) {
 	*out = *in
-	out.Manifest = in.Manifest
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultModelAliasSpec.
@@ -481,6 +485,26 @@ func (in *DefaultModelAliasStatus) DeepCopy() *DefaultModelAliasStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailMessage) DeepCopyInto(out *EmailMessage) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailMessage.
+func (in *EmailMessage) DeepCopy() *EmailMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmailReceiver) DeepCopyInto(out *EmailReceiver) {
 	*out = *in
@@ -571,6 +595,22 @@ func (in *EmailReceiverStatus) DeepCopy() *EmailReceiverStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EmailReplyFailure) DeepCopyInto(out *EmailReplyFailure) {
+	*out = *in
+	in.LastAttempt.DeepCopyInto(&out.LastAttempt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EmailReplyFailure.
+func (in *EmailReplyFailure) DeepCopy() *EmailReplyFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(EmailReplyFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyStatus) DeepCopyInto(out *EmptyStatus) {
 	*out = *in
@@ -1362,7 +1402,7 @@ func (in *Model) DeepCopyInto(out *Model) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	out.Status = in.Status
 }
 
@@ -1419,7 +1459,7 @@ func (in *ModelList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 	*out = *in
-	out.Manifest = in.Manifest
+	in.Manifest.DeepCopyInto(&out.Manifest)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
@@ -1447,6 +1487,125 @@ func (in *ModelStatus) DeepCopy() *ModelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationAttempt) DeepCopyInto(out *NotificationAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationAttempt.
+func (in *NotificationAttempt) DeepCopy() *NotificationAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDelivery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryList) DeepCopyInto(out *NotificationDeliveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryList.
+func (in *NotificationDeliveryList) DeepCopy() *NotificationDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationDeliveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliverySpec) DeepCopyInto(out *NotificationDeliverySpec) {
+	*out = *in
+	in.Sink.DeepCopyInto(&out.Sink)
+	in.Payload.DeepCopyInto(&out.Payload)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliverySpec.
+func (in *NotificationDeliverySpec) DeepCopy() *NotificationDeliverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDeliveryStatus) DeepCopyInto(out *NotificationDeliveryStatus) {
+	*out = *in
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]NotificationAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SentTo != nil {
+		in, out := &in.SentTo, &out.SentTo
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveryStatus.
+func (in *NotificationDeliveryStatus) DeepCopy() *NotificationDeliveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OAuthApp) DeepCopyInto(out *OAuthApp) {
 	*out = *in
@@ -1695,6 +1854,11 @@ func (in *RunSpec) DeepCopyInto(out *RunSpec) {
 		*out = make([]ExternalCallResult, len(*in))
 		copy(*out, *in)
 	}
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(ExternalCall)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunSpec.
@@ -2008,6 +2172,191 @@ func (in *SlackTriggerStatus) DeepCopy() *SlackTriggerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsMessage) DeepCopyInto(out *TeamsMessage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsMessage.
+func (in *TeamsMessage) DeepCopy() *TeamsMessage {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamsMessage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsMessageList) DeepCopyInto(out *TeamsMessageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TeamsMessage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsMessageList.
+func (in *TeamsMessageList) DeepCopy() *TeamsMessageList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsMessageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamsMessageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsMessageSpec) DeepCopyInto(out *TeamsMessageSpec) {
+	*out = *in
+	in.ReceivedAt.DeepCopyInto(&out.ReceivedAt)
+	if in.Activity != nil {
+		in, out := &in.Activity, &out.Activity
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsMessageSpec.
+func (in *TeamsMessageSpec) DeepCopy() *TeamsMessageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsMessageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsMessageStatus) DeepCopyInto(out *TeamsMessageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsMessageStatus.
+func (in *TeamsMessageStatus) DeepCopy() *TeamsMessageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsMessageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiver) DeepCopyInto(out *TeamsReceiver) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiver.
+func (in *TeamsReceiver) DeepCopy() *TeamsReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamsReceiver) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiverList) DeepCopyInto(out *TeamsReceiverList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TeamsReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiverList.
+func (in *TeamsReceiverList) DeepCopy() *TeamsReceiverList {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiverList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TeamsReceiverList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiverSpec) DeepCopyInto(out *TeamsReceiverSpec) {
+	*out = *in
+	out.Manifest = in.Manifest
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiverSpec.
+func (in *TeamsReceiverSpec) DeepCopy() *TeamsReceiverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamsReceiverStatus) DeepCopyInto(out *TeamsReceiverStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamsReceiverStatus.
+func (in *TeamsReceiverStatus) DeepCopy() *TeamsReceiverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamsReceiverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Thread) DeepCopyInto(out *Thread) {
 	*out = *in
@@ -2655,6 +3004,113 @@ func (in *Webhook) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDelivery) DeepCopyInto(out *WebhookDelivery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDelivery.
+func (in *WebhookDelivery) DeepCopy() *WebhookDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookDelivery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryList) DeepCopyInto(out *WebhookDeliveryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebhookDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryList.
+func (in *WebhookDeliveryList) DeepCopy() *WebhookDeliveryList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookDeliveryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliverySpec) DeepCopyInto(out *WebhookDeliverySpec) {
+	*out = *in
+	in.ReceivedAt.DeepCopyInto(&out.ReceivedAt)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliverySpec.
+func (in *WebhookDeliverySpec) DeepCopy() *WebhookDeliverySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliverySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookDeliveryStatus) DeepCopyInto(out *WebhookDeliveryStatus) {
+	*out = *in
+	if in.WorkflowExecutionNames != nil {
+		in, out := &in.WorkflowExecutionNames, &out.WorkflowExecutionNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookDeliveryStatus.
+func (in *WebhookDeliveryStatus) DeepCopy() *WebhookDeliveryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookDeliveryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookList) DeepCopyInto(out *WebhookList) {
 	*out = *in
@@ -2733,7 +3189,7 @@ func (in *Workflow) DeepCopyInto(out *Workflow) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Workflow.
@@ -2759,7 +3215,7 @@ func (in *WorkflowExecution) DeepCopyInto(out *WorkflowExecution) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -2816,6 +3272,16 @@ func (in *WorkflowExecutionList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowExecutionSpec) DeepCopyInto(out *WorkflowExecutionSpec) {
 	*out = *in
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
+		*out = new(types.WorkflowManifest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EmailMessage != nil {
+		in, out := &in.EmailMessage, &out.EmailMessage
+		*out = new(EmailMessage)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowExecutionSpec.
@@ -2840,6 +3306,11 @@ func (in *WorkflowExecutionStatus) DeepCopyInto(out *WorkflowExecutionStatus) {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.EmailReplyFailure != nil {
+		in, out := &in.EmailReplyFailure, &out.EmailReplyFailure
+		*out = new(EmailReplyFailure)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowExecutionStatus.
@@ -2903,6 +3374,10 @@ func (in *WorkflowSpec) DeepCopy() *WorkflowSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowStatus) DeepCopyInto(out *WorkflowStatus) {
 	*out = *in
+	if in.OnTaskCompletionSince != nil {
+		in, out := &in.OnTaskCompletionSince, &out.OnTaskCompletionSince
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowStatus.
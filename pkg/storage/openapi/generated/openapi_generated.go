@@ -33,6 +33,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.AuthProviderManifest":                         schema_obot_platform_obot_apiclient_types_AuthProviderManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.AuthProviderStatus":                           schema_obot_platform_obot_apiclient_types_AuthProviderStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.AuthorizationList":                            schema_obot_platform_obot_apiclient_types_AuthorizationList(ref),
+		"github.com/obot-platform/obot/apiclient/types.Budget":                                       schema_obot_platform_obot_apiclient_types_Budget(ref),
+		"github.com/obot-platform/obot/apiclient/types.BudgetLimit":                                  schema_obot_platform_obot_apiclient_types_BudgetLimit(ref),
+		"github.com/obot-platform/obot/apiclient/types.BudgetList":                                   schema_obot_platform_obot_apiclient_types_BudgetList(ref),
+		"github.com/obot-platform/obot/apiclient/types.BudgetManifest":                               schema_obot_platform_obot_apiclient_types_BudgetManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.CommonProviderMetadata":                       schema_obot_platform_obot_apiclient_types_CommonProviderMetadata(ref),
 		"github.com/obot-platform/obot/apiclient/types.CommonProviderStatus":                         schema_obot_platform_obot_apiclient_types_CommonProviderStatus(ref),
 		"github.com/obot-platform/obot/apiclient/types.Credential":                                   schema_obot_platform_obot_apiclient_types_Credential(ref),
@@ -43,6 +47,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAlias":                            schema_obot_platform_obot_apiclient_types_DefaultModelAlias(ref),
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasList":                        schema_obot_platform_obot_apiclient_types_DefaultModelAliasList(ref),
 		"github.com/obot-platform/obot/apiclient/types.DefaultModelAliasManifest":                    schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions":                       schema_obot_platform_obot_apiclient_types_EmailAttachmentOptions(ref),
 		"github.com/obot-platform/obot/apiclient/types.EmailReceiver":                                schema_obot_platform_obot_apiclient_types_EmailReceiver(ref),
 		"github.com/obot-platform/obot/apiclient/types.EmailReceiverList":                            schema_obot_platform_obot_apiclient_types_EmailReceiverList(ref),
 		"github.com/obot-platform/obot/apiclient/types.EmailReceiverManifest":                        schema_obot_platform_obot_apiclient_types_EmailReceiverManifest(ref),
@@ -74,11 +79,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.Model":                                        schema_obot_platform_obot_apiclient_types_Model(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelList":                                    schema_obot_platform_obot_apiclient_types_ModelList(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelManifest":                                schema_obot_platform_obot_apiclient_types_ModelManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelPricing":                                 schema_obot_platform_obot_apiclient_types_ModelPricing(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelProvider":                                schema_obot_platform_obot_apiclient_types_ModelProvider(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelProviderList":                            schema_obot_platform_obot_apiclient_types_ModelProviderList(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelProviderManifest":                        schema_obot_platform_obot_apiclient_types_ModelProviderManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelProviderStatus":                          schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.ModelResponseCache":                           schema_obot_platform_obot_apiclient_types_ModelResponseCache(ref),
 		"github.com/obot-platform/obot/apiclient/types.ModelStatus":                                  schema_obot_platform_obot_apiclient_types_ModelStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationAttempt":                          schema_obot_platform_obot_apiclient_types_NotificationAttempt(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationDelivery":                         schema_obot_platform_obot_apiclient_types_NotificationDelivery(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationDeliveryList":                     schema_obot_platform_obot_apiclient_types_NotificationDeliveryList(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationEmail":                            schema_obot_platform_obot_apiclient_types_NotificationEmail(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationHTTP":                             schema_obot_platform_obot_apiclient_types_NotificationHTTP(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationPayload":                          schema_obot_platform_obot_apiclient_types_NotificationPayload(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationSink":                             schema_obot_platform_obot_apiclient_types_NotificationSink(ref),
+		"github.com/obot-platform/obot/apiclient/types.NotificationSlack":                            schema_obot_platform_obot_apiclient_types_NotificationSlack(ref),
 		"github.com/obot-platform/obot/apiclient/types.NotionConfig":                                 schema_obot_platform_obot_apiclient_types_NotionConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthApp":                                     schema_obot_platform_obot_apiclient_types_OAuthApp(ref),
 		"github.com/obot-platform/obot/apiclient/types.OAuthAppList":                                 schema_obot_platform_obot_apiclient_types_OAuthAppList(ref),
@@ -102,28 +117,47 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.Prompt":                                       schema_obot_platform_obot_apiclient_types_Prompt(ref),
 		"github.com/obot-platform/obot/apiclient/types.PromptResponse":                               schema_obot_platform_obot_apiclient_types_PromptResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.ProviderConfigurationParameter":               schema_obot_platform_obot_apiclient_types_ProviderConfigurationParameter(ref),
+		"github.com/obot-platform/obot/apiclient/types.RemainingBudget":                              schema_obot_platform_obot_apiclient_types_RemainingBudget(ref),
 		"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage":                          schema_obot_platform_obot_apiclient_types_RemainingTokenUsage(ref),
 		"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsageList":                      schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref),
 		"github.com/obot-platform/obot/apiclient/types.Run":                                          schema_obot_platform_obot_apiclient_types_Run(ref),
 		"github.com/obot-platform/obot/apiclient/types.RunList":                                      schema_obot_platform_obot_apiclient_types_RunList(ref),
 		"github.com/obot-platform/obot/apiclient/types.Schedule":                                     schema_obot_platform_obot_apiclient_types_Schedule(ref),
+		"github.com/obot-platform/obot/apiclient/types.ScheduleBlackout":                             schema_obot_platform_obot_apiclient_types_ScheduleBlackout(ref),
+		"github.com/obot-platform/obot/apiclient/types.ScheduleOptions":                              schema_obot_platform_obot_apiclient_types_ScheduleOptions(ref),
 		"github.com/obot-platform/obot/apiclient/types.SlackReceiver":                                schema_obot_platform_obot_apiclient_types_SlackReceiver(ref),
 		"github.com/obot-platform/obot/apiclient/types.SlackReceiverList":                            schema_obot_platform_obot_apiclient_types_SlackReceiverList(ref),
 		"github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest":                        schema_obot_platform_obot_apiclient_types_SlackReceiverManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.Step":                                         schema_obot_platform_obot_apiclient_types_Step(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepApproval":                                 schema_obot_platform_obot_apiclient_types_StepApproval(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepApprovalRequest":                          schema_obot_platform_obot_apiclient_types_StepApprovalRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepApprovalStatus":                           schema_obot_platform_obot_apiclient_types_StepApprovalStatus(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepIf":                                       schema_obot_platform_obot_apiclient_types_StepIf(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepSwitch":                                   schema_obot_platform_obot_apiclient_types_StepSwitch(ref),
+		"github.com/obot-platform/obot/apiclient/types.StepTask":                                     schema_obot_platform_obot_apiclient_types_StepTask(ref),
 		"github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke":                           schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref),
+		"github.com/obot-platform/obot/apiclient/types.SwitchCase":                                   schema_obot_platform_obot_apiclient_types_SwitchCase(ref),
 		"github.com/obot-platform/obot/apiclient/types.Table":                                        schema_obot_platform_obot_apiclient_types_Table(ref),
 		"github.com/obot-platform/obot/apiclient/types.TableList":                                    schema_obot_platform_obot_apiclient_types_TableList(ref),
 		"github.com/obot-platform/obot/apiclient/types.Task":                                         schema_obot_platform_obot_apiclient_types_Task(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskConcurrency":                              schema_obot_platform_obot_apiclient_types_TaskConcurrency(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskEmail":                                    schema_obot_platform_obot_apiclient_types_TaskEmail(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskList":                                     schema_obot_platform_obot_apiclient_types_TaskList(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskManifest":                                 schema_obot_platform_obot_apiclient_types_TaskManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskOnDemand":                                 schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage":                           schema_obot_platform_obot_apiclient_types_TaskOnSlackMessage(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskOnTaskCompletion":                         schema_obot_platform_obot_apiclient_types_TaskOnTaskCompletion(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskRun":                                      schema_obot_platform_obot_apiclient_types_TaskRun(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRunDiff":                                  schema_obot_platform_obot_apiclient_types_TaskRunDiff(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskRunList":                                  schema_obot_platform_obot_apiclient_types_TaskRunList(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRunReplayRequest":                         schema_obot_platform_obot_apiclient_types_TaskRunReplayRequest(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRunStepDiff":                              schema_obot_platform_obot_apiclient_types_TaskRunStepDiff(ref),
+		"github.com/obot-platform/obot/apiclient/types.TaskRunStepResult":                            schema_obot_platform_obot_apiclient_types_TaskRunStepResult(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskStep":                                     schema_obot_platform_obot_apiclient_types_TaskStep(ref),
 		"github.com/obot-platform/obot/apiclient/types.TaskWebhook":                                  schema_obot_platform_obot_apiclient_types_TaskWebhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.TeamsReceiver":                                schema_obot_platform_obot_apiclient_types_TeamsReceiver(ref),
+		"github.com/obot-platform/obot/apiclient/types.TeamsReceiverList":                            schema_obot_platform_obot_apiclient_types_TeamsReceiverList(ref),
+		"github.com/obot-platform/obot/apiclient/types.TeamsReceiverManifest":                        schema_obot_platform_obot_apiclient_types_TeamsReceiverManifest(ref),
 		"github.com/obot-platform/obot/apiclient/types.TemplateAuthorization":                        schema_obot_platform_obot_apiclient_types_TemplateAuthorization(ref),
 		"github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationList":                    schema_obot_platform_obot_apiclient_types_TemplateAuthorizationList(ref),
 		"github.com/obot-platform/obot/apiclient/types.TemplateAuthorizationManifest":                schema_obot_platform_obot_apiclient_types_TemplateAuthorizationManifest(ref),
@@ -147,8 +181,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/apiclient/types.User":                                         schema_obot_platform_obot_apiclient_types_User(ref),
 		"github.com/obot-platform/obot/apiclient/types.UserList":                                     schema_obot_platform_obot_apiclient_types_UserList(ref),
 		"github.com/obot-platform/obot/apiclient/types.Webhook":                                      schema_obot_platform_obot_apiclient_types_Webhook(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookDelivery":                              schema_obot_platform_obot_apiclient_types_WebhookDelivery(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookDeliveryList":                          schema_obot_platform_obot_apiclient_types_WebhookDeliveryList(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebhookList":                                  schema_obot_platform_obot_apiclient_types_WebhookList(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebhookManifest":                              schema_obot_platform_obot_apiclient_types_WebhookManifest(ref),
+		"github.com/obot-platform/obot/apiclient/types.WebhookPendingResponse":                       schema_obot_platform_obot_apiclient_types_WebhookPendingResponse(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebsiteCrawlingConfig":                        schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebsiteDefinition":                            schema_obot_platform_obot_apiclient_types_WebsiteDefinition(ref),
 		"github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge":                             schema_obot_platform_obot_apiclient_types_WebsiteKnowledge(ref),
@@ -176,10 +213,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.DefaultModelAliasList":       schema_storage_apis_obotobotai_v1_DefaultModelAliasList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.DefaultModelAliasSpec":       schema_storage_apis_obotobotai_v1_DefaultModelAliasSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.DefaultModelAliasStatus":     schema_storage_apis_obotobotai_v1_DefaultModelAliasStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailMessage":                schema_storage_apis_obotobotai_v1_EmailMessage(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReceiver":               schema_storage_apis_obotobotai_v1_EmailReceiver(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReceiverList":           schema_storage_apis_obotobotai_v1_EmailReceiverList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReceiverSpec":           schema_storage_apis_obotobotai_v1_EmailReceiverSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReceiverStatus":         schema_storage_apis_obotobotai_v1_EmailReceiverStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReplyFailure":           schema_storage_apis_obotobotai_v1_EmailReplyFailure(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus":                 schema_storage_apis_obotobotai_v1_EmptyStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall":                schema_storage_apis_obotobotai_v1_ExternalCall(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult":          schema_storage_apis_obotobotai_v1_ExternalCallResult(ref),
@@ -220,6 +259,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ModelList":                   schema_storage_apis_obotobotai_v1_ModelList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ModelSpec":                   schema_storage_apis_obotobotai_v1_ModelSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ModelStatus":                 schema_storage_apis_obotobotai_v1_ModelStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationAttempt":         schema_storage_apis_obotobotai_v1_NotificationAttempt(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDelivery":        schema_storage_apis_obotobotai_v1_NotificationDelivery(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliveryList":    schema_storage_apis_obotobotai_v1_NotificationDeliveryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliverySpec":    schema_storage_apis_obotobotai_v1_NotificationDeliverySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliveryStatus":  schema_storage_apis_obotobotai_v1_NotificationDeliveryStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OAuthApp":                    schema_storage_apis_obotobotai_v1_OAuthApp(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OAuthAppList":                schema_storage_apis_obotobotai_v1_OAuthAppList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.OAuthAppLogin":               schema_storage_apis_obotobotai_v1_OAuthAppLogin(ref),
@@ -243,6 +287,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerList":            schema_storage_apis_obotobotai_v1_SlackTriggerList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerSpec":            schema_storage_apis_obotobotai_v1_SlackTriggerSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerStatus":          schema_storage_apis_obotobotai_v1_SlackTriggerStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessage":                schema_storage_apis_obotobotai_v1_TeamsMessage(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageList":            schema_storage_apis_obotobotai_v1_TeamsMessageList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageSpec":            schema_storage_apis_obotobotai_v1_TeamsMessageSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageStatus":          schema_storage_apis_obotobotai_v1_TeamsMessageStatus(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiver":               schema_storage_apis_obotobotai_v1_TeamsReceiver(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverList":           schema_storage_apis_obotobotai_v1_TeamsReceiverList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverSpec":           schema_storage_apis_obotobotai_v1_TeamsReceiverSpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverStatus":         schema_storage_apis_obotobotai_v1_TeamsReceiverStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Thread":                      schema_storage_apis_obotobotai_v1_Thread(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadAuthorization":         schema_storage_apis_obotobotai_v1_ThreadAuthorization(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ThreadAuthorizationList":     schema_storage_apis_obotobotai_v1_ThreadAuthorizationList(ref),
@@ -269,6 +321,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteList":              schema_storage_apis_obotobotai_v1_UserDeleteList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteSpec":              schema_storage_apis_obotobotai_v1_UserDeleteSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Webhook":                     schema_storage_apis_obotobotai_v1_Webhook(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDelivery":             schema_storage_apis_obotobotai_v1_WebhookDelivery(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliveryList":         schema_storage_apis_obotobotai_v1_WebhookDeliveryList(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliverySpec":         schema_storage_apis_obotobotai_v1_WebhookDeliverySpec(ref),
+		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliveryStatus":       schema_storage_apis_obotobotai_v1_WebhookDeliveryStatus(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookList":                 schema_storage_apis_obotobotai_v1_WebhookList(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookSpec":                 schema_storage_apis_obotobotai_v1_WebhookSpec(ref),
 		"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookStatus":               schema_storage_apis_obotobotai_v1_WebhookStatus(ref),
@@ -1136,6 +1192,163 @@ func schema_obot_platform_obot_apiclient_types_AuthorizationList(ref common.Refe
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_Budget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"BudgetManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.BudgetManifest"),
+						},
+					},
+				},
+				Required: []string{"Metadata", "BudgetManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.BudgetManifest", "github.com/obot-platform/obot/apiclient/types.Metadata"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BudgetLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BudgetLimit is a number of tokens, a cost in dollars, or both. A limit of zero is not enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tokens": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BudgetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Budget"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Budget"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_BudgetManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BudgetManifest limits the LLM usage in a window of time. The usage of a budget is the usage that matches all of its scope fields: the project, the agent, the users, and the model. A budget with none of them set limits all usage.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"agentID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AgentID is the ID of an agent. The agent of usage is only recorded since budgets were added, so a budget for an agent does not count older usage.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model is the ID of a model. Requests are sent to the fallbacks of the model when a hard limit of the budget is reached, instead of being blocked.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"window": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"soft": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Soft is the usage at which the budget warns that it is almost used up.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.BudgetLimit"),
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the usage at which requests are blocked until the next window.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.BudgetLimit"),
+						},
+					},
+				},
+				Required: []string{"window", "soft", "hard"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.BudgetLimit"},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_CommonProviderMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1352,6 +1565,12 @@ func schema_obot_platform_obot_apiclient_types_CronJob(ref common.ReferenceCallb
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
+					"runCount": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 				},
 				Required: []string{"Metadata", "CronJobManifest"},
 			},
@@ -1424,11 +1643,53 @@ func schema_obot_platform_obot_apiclient_types_CronJobManifest(ref common.Refere
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp is what to do about ticks of the schedule that were missed, such as while the server was down. The default is \"runOnce\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the maximum random delay added to each run, such as \"10m\", to spread out runs that are scheduled at the same time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blackouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blackouts are ranges of dates on which the schedule does not run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"),
+									},
+								},
+							},
+						},
+					},
+					"endAfterRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfterRuns stops the schedule after it has run this many times.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfter stops the schedule after this date, such as \"2025-12-31\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Schedule"},
+			"github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"},
 	}
 }
 
@@ -1501,6 +1762,28 @@ func schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref com
 							Format:  "",
 						},
 					},
+					"fallbacks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fallbacks are the models, in order, that requests are sent to when the model, or the fallback before them, fails with a rate limit, a server error, or a timeout before its response starts.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"fallbackTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FallbackTimeout is how long to wait for the response of a model to start before trying the next fallback, such as \"30s\". The default is 60 seconds. Requests that are not streamed are given at least 10 minutes, because their response only starts once it is complete. The last model is not timed out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"alias", "model"},
 			},
@@ -1508,6 +1791,47 @@ func schema_obot_platform_obot_apiclient_types_DefaultModelAliasManifest(ref com
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_EmailAttachmentOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size in bytes of each attachment. Larger attachments are skipped. The default is 10 MB.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"allowedTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedTypes are the content types of the attachments that are saved, such as \"application/pdf\" or \"image/*\". If empty, all types are saved.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled skips all attachments.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_EmailReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1622,10 +1946,32 @@ func schema_obot_platform_obot_apiclient_types_EmailReceiverManifest(ref common.
 							},
 						},
 					},
+					"attachments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attachments limits the attachments that are saved to the workspace of the triggered task run.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"),
+						},
+					},
+					"reply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reply sends the output of the triggered task run as a reply to the email. Replies to the reply continue the same task run instead of starting a new one.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"senderAuthentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SenderAuthentication is what to do with emails that are not authenticated by the domain of their sender.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "description", "workflowName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"},
 	}
 }
 
@@ -2718,43 +3064,88 @@ func schema_obot_platform_obot_apiclient_types_ModelManifest(ref common.Referenc
 							Format:  "",
 						},
 					},
+					"pricing": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.ModelPricing"),
+						},
+					},
+					"responseCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResponseCache caches the responses of the model in the LLM proxy, so that identical requests are not sent to the model provider again.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.ModelResponseCache"),
+						},
+					},
 				},
 				Required: []string{"active", "usage"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ModelPricing", "github.com/obot-platform/obot/apiclient/types.ModelResponseCache"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelPricing(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ModelPricing is the price of a model in dollars per million tokens. It is used to compute the cost of the LLM usage of the model.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"inputPerMillion": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
-					"ModelProviderManifest": {
+					"outputPerMillion": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderManifest"),
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
-					"ModelProviderStatus": {
+					"cachedInputPerMillion": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"),
+							Description: "CachedInputPerMillion is the price of prompt tokens that are read from the cache of the model provider. The input price is used if it is not set.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
 				},
-				Required: []string{"Metadata", "ModelProviderManifest", "ModelProviderStatus"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelProviderManifest", "github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ModelProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"ModelProviderManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderManifest"),
+						},
+					},
+					"ModelProviderStatus": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"),
+						},
+					},
+				},
+				Required: []string{"Metadata", "ModelProviderManifest", "ModelProviderStatus"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ModelProviderManifest", "github.com/obot-platform/obot/apiclient/types.ModelProviderStatus"},
 	}
 }
 
@@ -2840,113 +3231,76 @@ func schema_obot_platform_obot_apiclient_types_ModelProviderStatus(ref common.Re
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelResponseCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ModelResponseCache configures the caching of responses of a model. Only responses to chat completion requests with a temperature of 0 are cached.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"aliasAssigned": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
-					"modelProviderName": {
+					"ttl": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "TTL is how long a response is cached, as a duration such as \"1h\". It is 24 hours if it is not set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"modelProviderName"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_ModelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"OAuthAppManifest": {
+					"aliasAssigned": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"OAuthAppManifest"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"modelProviderName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"modelProviderName"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"authenticated": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"required": {
+					"statusCode": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "StatusCode is the HTTP status of the response, for Slack and HTTP sinks.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 					"error": {
@@ -2956,12 +3310,15 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref commo
 						},
 					},
 				},
+				Required: []string{"time"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -2973,112 +3330,109 @@ func schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref common.Refer
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"type": {
+					"sinkName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clientID": {
+					"sinkType": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clientSecret": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"authURL": {
-						SchemaProps: spec.SchemaProps{
-							Description: "These fields are only needed for custom OAuth apps.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"tokenURL": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"tenantID": {
+					"event": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only needed for Microsoft 365 OAuth apps.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"appID": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only needed for HubSpot OAuth apps.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"optionalScope": {
+					"runID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is optional for HubSpot OAuth apps.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"alias": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is required, it correlates to the integration name in the gptscript oauth cred tool",
+							Description: "State is pending while the notification is being sent or retried, and then delivered or failed.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"global": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Global indicates if the OAuth app is globally applied to all agents. Defaults to false.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"instanceURL": {
+					"attempts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is only used by Salesforce",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationAttempt"),
+									},
+								},
+							},
 						},
 					},
-					"gitlabBaseURL": {
+				},
+				Required: []string{"Metadata", "sinkName", "sinkType", "event", "taskID", "runID", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.NotificationAttempt"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_NotificationDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "This field is used for GitLab enterprise instances",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationDelivery"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"Metadata", "type", "clientID"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata"},
+			"github.com/obot-platform/obot/apiclient/types.NotificationDelivery"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"sharedLinks": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "To are the addresses that the notification is sent to. Email requires an SMTP relay to be configured.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -3091,236 +3445,234 @@ func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.Referen
 						},
 					},
 				},
+				Required: []string{"to"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationHTTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"runID": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RunID should be populated for all progress events to associate this event with a run If RunID is not populated, the event will not specify tied to any particular run",
+							Description: "URL receives a POST of a NotificationPayload as JSON.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"threadID": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ThreadID may be populated on any event indicating the current thread of the event stream",
+							Description: "Secret signs the body of each request. The signature is the hex-encoded HMAC-SHA256 of the body with a \"sha256=\" prefix in the X-Obot-Signature header. The secret is not returned by the API; leave it empty when updating to keep the existing secret.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"parentRunID": {
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_NotificationPayload(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationPayload is the body of the requests to HTTP sinks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"event": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ParentRunID is the parent run of the run that is specified in the RunID field",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"time": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Time is the time the event was generated",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"content": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Content is the output data. The content for all events should be concatenated to form the entire output If you wish to print event and are not concerned with tracking the internal progress when one can just only the content field in a very simple loop",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"contentID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ContentID is a unique identifier for the content. This is used to track the content across multiple events. This field applies to Content and ToolInput.Content fields.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"input": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Some input that was provided to the run",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"inputIsStepTemplateInput": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InputIsStepTemplateInput indicates that the input will be passed to a step template. Later an event will be sent with the step template invoke information in the StepTemplateInvoke field",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"stepTemplateInvoke": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StepTemplateInvoke indicates that a step template is being invoked",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"prompt": {
+					"taskName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If prompt is set, content will also be set, but you can ignore the content field and instead handle the explicit information in the prompt field which will provider more information for things such as OAuth",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Prompt"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"step": {
+					"runID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The step that is currently being executed. When this is set the following events are assumed to be part of this step until the next step is set. This field is not always set, only set when the set changes",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Step"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"toolInput": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ToolInput indicates the LLM is currently generating tool arguments which can sometime take a while",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.ToolInput"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"toolCall": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ToolCall indicates the LLM is currently calling a tool.",
-							Ref:         ref("github.com/obot-platform/obot/apiclient/types.ToolCall"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"waitingOnModel": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WaitingOnModel indicates we are waiting for the model to start responding with content",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"error": {
+					"stepID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Error indicates that an error occurred",
+							Description: "StepID and Message are the step that waits for approval and its approval message.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runComplete": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The run is done, either success or failure",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"replayComplete": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReplayComplete indicates that all existing events have been sent and future events will be new events",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
 				},
-				Required: []string{"content"},
+				Required: []string{"event", "projectID", "taskID", "runID", "state", "time"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Prompt", "github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.ToolCall", "github.com/obot-platform/obot/apiclient/types.ToolInput"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NotificationSink is a destination that is notified when runs of tasks finish or wait for approval. Exactly one of Slack, HTTP, or Email must be set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"ProjectManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectManifest"),
-						},
-					},
-					"assistantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"editor": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "Name identifies the sink in the delivery log and must be unique among the sinks of a task or project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"parentID": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Events are the events that are sent to the sink. The default is all events.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"sourceProjectID": {
+					"slack": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NotificationSlack"),
 						},
 					},
-					"userID": {
+					"http": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NotificationHTTP"),
 						},
 					},
-					"capabilities": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectCapabilities"),
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.NotificationEmail"),
 						},
 					},
 				},
-				Required: []string{"Metadata", "ProjectManifest", "editor", "capabilities"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ProjectCapabilities", "github.com/obot-platform/obot/apiclient/types.ProjectManifest"},
+			"github.com/obot-platform/obot/apiclient/types.NotificationEmail", "github.com/obot-platform/obot/apiclient/types.NotificationHTTP", "github.com/obot-platform/obot/apiclient/types.NotificationSlack"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_NotificationSlack(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"project": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Project"),
-						},
-					},
-					"target": {
+					"webhookURL": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "WebhookURL is the URL of a Slack incoming webhook. The URL is a secret, so it is not returned by the API; leave it empty when updating to keep the existing URL.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"accepted": {
+				},
+				Required: []string{"webhookURL"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_NotionConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OAuthApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"OAuthAppManifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"),
 						},
 					},
 				},
+				Required: []string{"OAuthAppManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Project"},
+			"github.com/obot-platform/obot/apiclient/types.OAuthAppManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3333,7 +3685,7 @@ func schema_obot_platform_obot_apiclient_types_ProjectAuthorizationList(ref comm
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectAuthorization"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.OAuthApp"),
 									},
 								},
 							},
@@ -3344,274 +3696,1775 @@ func schema_obot_platform_obot_apiclient_types_ProjectAuthorizationList(ref comm
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ProjectAuthorization"},
+			"github.com/obot-platform/obot/apiclient/types.OAuthApp"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppLoginAuthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"onSlackMessage": {
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"authenticated": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"required": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_OAuthAppManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"toolID": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"toolName": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"icon": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"exists": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
+							Default: "",
+							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-				},
-				Required: []string{"exists"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ProjectCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"items": {
+					"clientSecret": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"authURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "These fields are only needed for custom OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tokenURL": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is only needed for Microsoft 365 OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is only needed for HubSpot OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"optionalScope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is optional for HubSpot OAuth apps.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alias": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is required, it correlates to the integration name in the gptscript oauth cred tool",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"global": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Global indicates if the OAuth app is globally applied to all agents. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"instanceURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is only used by Salesforce",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gitlabBaseURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "This field is used for GitLab enterprise instances",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "type", "clientID"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_OneDriveConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sharedLinks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunID should be populated for all progress events to associate this event with a run If RunID is not populated, the event will not specify tied to any particular run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threadID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadID may be populated on any event indicating the current thread of the event stream",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parentRunID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParentRunID is the parent run of the run that is specified in the RunID field",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time the event was generated",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the output data. The content for all events should be concatenated to form the entire output If you wish to print event and are not concerned with tracking the internal progress when one can just only the content field in a very simple loop",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contentID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentID is a unique identifier for the content. This is used to track the content across multiple events. This field applies to Content and ToolInput.Content fields.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Some input that was provided to the run",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"inputIsStepTemplateInput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InputIsStepTemplateInput indicates that the input will be passed to a step template. Later an event will be sent with the step template invoke information in the StepTemplateInvoke field",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"stepTemplateInvoke": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepTemplateInvoke indicates that a step template is being invoked",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke"),
+						},
+					},
+					"prompt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If prompt is set, content will also be set, but you can ignore the content field and instead handle the explicit information in the prompt field which will provider more information for things such as OAuth",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Prompt"),
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The step that is currently being executed. When this is set the following events are assumed to be part of this step until the next step is set. This field is not always set, only set when the set changes",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.Step"),
+						},
+					},
+					"toolInput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolInput indicates the LLM is currently generating tool arguments which can sometime take a while",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.ToolInput"),
+						},
+					},
+					"toolCall": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToolCall indicates the LLM is currently calling a tool.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.ToolCall"),
+						},
+					},
+					"waitingOnModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitingOnModel indicates we are waiting for the model to start responding with content",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error indicates that an error occurred",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runComplete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The run is done, either success or failure",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"replayComplete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayComplete indicates that all existing events have been sent and future events will be new events",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"content"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Prompt", "github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.StepTemplateInvoke", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.ToolCall", "github.com/obot-platform/obot/apiclient/types.ToolInput"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"ProjectManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectManifest"),
+						},
+					},
+					"assistantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"editor": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"parentID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sourceProjectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"capabilities": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectCapabilities"),
+						},
+					},
+				},
+				Required: []string{"Metadata", "ProjectManifest", "editor", "capabilities"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ProjectCapabilities", "github.com/obot-platform/obot/apiclient/types.ProjectManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"project": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Project"),
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"accepted": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Project"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectAuthorizationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectAuthorization"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ProjectAuthorization"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"onSlackMessage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"toolID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"toolName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"icon": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"exists": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"exists"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectCredential"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ProjectCredential"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Project"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Project"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ThreadManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+						},
+					},
+				},
+				Required: []string{"ThreadManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectShare(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"ProjectShareManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectShareManifest"),
+						},
+					},
+					"publicID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"icons": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
+						},
+					},
+					"featured": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"tools": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"editor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "ProjectShareManifest", "icons"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ProjectShareManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectShareList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectShare"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ProjectShare"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectShareManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"public": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"ThreadManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+						},
+					},
+					"tasks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+									},
+								},
+							},
+						},
+					},
+					"assistantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"publicID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "ThreadManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProjectTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ProjectTemplate"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Prompt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Field"),
+									},
+								},
+							},
+						},
+					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Field", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_PromptResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ProviderConfigurationParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"friendlyName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"hidden": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_RemainingBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemainingBudget is the usage of a budget in its current window, and how much of its hard limit is left.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"budgetID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"usedTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"usedCost": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"remainingTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"remainingCost": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"unlimitedTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"unlimitedCost": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"budgetID", "start", "end", "usedTokens", "usedCost", "remainingTokens", "remainingCost", "unlimitedTokens", "unlimitedCost", "state"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_RemainingTokenUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"promptTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"completionTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"unlimitedPromptTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"unlimitedCompletionTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"promptTokens", "completionTokens", "unlimitedPromptTokens", "unlimitedCompletionTokens"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Run(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"threadID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"agentID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowStepID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"previousRunID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"input"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_RunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Run"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Run"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Schedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Valid values are: \"hourly\", \"daily\", \"weekly\", \"monthly\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hour": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"minute": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"day": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"weekday": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp is what to do about ticks of the schedule that were missed, such as while the server was down. The default is \"runOnce\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the maximum random delay added to each run, such as \"10m\", to spread out runs that are scheduled at the same time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blackouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blackouts are ranges of dates on which the schedule does not run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"),
+									},
+								},
+							},
+						},
+					},
+					"endAfterRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfterRuns stops the schedule after it has run this many times.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfter stops the schedule after this date, such as \"2025-12-31\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"interval", "hour", "minute", "day", "weekday", "timezone"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ScheduleBlackout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleBlackout is an inclusive range of dates, such as \"2025-12-24\" to \"2025-12-26\", in the time zone of the schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_ScheduleOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp is what to do about ticks of the schedule that were missed, such as while the server was down. The default is \"runOnce\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the maximum random delay added to each run, such as \"10m\", to spread out runs that are scheduled at the same time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blackouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blackouts are ranges of dates on which the schedule does not run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"),
+									},
+								},
+							},
+						},
+					},
+					"endAfterRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfterRuns stops the schedule after it has run this many times.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfter stops the schedule after this date, such as \"2025-12-31\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_SlackReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"SlackReceiverManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"),
+						},
+					},
+					"clientSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"signingSecret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "SlackReceiverManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_SlackReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiver"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.SlackReceiver"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_SlackReceiverManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SlackReceiverManifest defines the configuration for a Slack receiver",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppID corresponds to the App ID of the Slack app. It's important to not that this value is not validated. The user can insert whatever they want here. Don't trust this value. Use the value from oauth flow to validate the app.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Step(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"loop": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"if": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StepIf"),
+						},
+					},
+					"switch": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StepSwitch"),
+						},
+					},
+					"parallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallel steps run at the same time. Step, if set, is used as the instructions for joining their outputs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectCredential"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
 									},
 								},
 							},
 						},
 					},
+					"outputSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputSchema is a JSON Schema, as a JSON string, that the output of the step must match. The model is asked to respond with JSON that matches it and is asked to correct its response if it does not.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approval pauses the task until a user approves or rejects it.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepApproval"),
+						},
+					},
+					"task": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Task runs another task in the same project and waits for its output. Step, if set, is used as the instructions for using its output.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepTask"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Concurrency is the maximum number of loop elements or parallel steps that run at the same time. Loops run one element at a time by default and parallel steps all run at once by default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is the number of times the step is run again after it fails.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is how long to wait before the first retry, such as \"30s\". The wait doubles for each retry after that.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is how long each attempt of the step can run before it fails, such as \"5m\". The default is 10 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ProjectCredential"},
+			"github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.StepApproval", "github.com/obot-platform/obot/apiclient/types.StepIf", "github.com/obot-platform/obot/apiclient/types.StepSwitch", "github.com/obot-platform/obot/apiclient/types.StepTask"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepApproval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Message describes what is being approved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"approvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvers are the IDs or email addresses of the users that can approve or reject. If empty, any user with access to the task can.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Project"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is how long to wait for a decision, such as \"24h\". If unset, the task waits until a decision is made.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"defaultAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DefaultAction is the decision made when the timeout is reached, either \"approve\" or \"reject\". The default is \"reject\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Project"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepApprovalRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "StepApprovalRequest is the body used to approve or reject an approval step. An empty body approves.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ThreadManifest": {
+					"reject": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"ThreadManifest"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectShare(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepApprovalStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"ProjectShareManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectShareManifest"),
-						},
-					},
-					"publicID": {
+					"stepID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"projectID": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"name": {
+					"requestedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"description": {
+					"decision": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"icons": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.AgentIcons"),
-						},
-					},
-					"featured": {
+					"decidedBy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"tools": {
+					"decidedAt": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
-					"editor": {
+					"comment": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"Metadata", "ProjectShareManifest", "icons"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.ProjectShareManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectShareList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepIf(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "StepIf runs Steps when the condition is true and Else otherwise. The condition is evaluated by the model unless Expression is set, in which case it is evaluated against the workflow input and prior step outputs.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"condition": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"steps": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectShare"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ProjectShare"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_ProjectShareManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"public": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"users": {
+					"else": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
 									},
 								},
 							},
@@ -3620,113 +5473,105 @@ func schema_obot_platform_obot_apiclient_types_ProjectShareManifest(ref common.R
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Step"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepSwitch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "StepSwitch runs the steps of the first case whose value matches, or Default if none match. The value is chosen by the model based on Value unless Expression is set.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"ThreadManifest": {
+					"expression": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ThreadManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"tasks": {
+					"cases": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.SwitchCase"),
 									},
 								},
 							},
 						},
 					},
-					"assistantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"projectID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"publicID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"ready": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"Metadata", "ThreadManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.ThreadManifest"},
+			"github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.SwitchCase"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProjectTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepTask(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ID is the ID of the task to run.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Params maps the parameters of the task to expressions, such as \"input.city\" or \"'London'\", that are evaluated the same way as the expression of an if step.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ProjectTemplate"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ProjectTemplate"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Prompt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -3739,46 +5584,53 @@ func schema_obot_platform_obot_apiclient_types_Prompt(ref common.ReferenceCallba
 							Format: "",
 						},
 					},
-					"time": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"fields": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Field"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"sensitive": {
+					"result": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"metadata": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_SwitchCase(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
 									},
 								},
 							},
@@ -3788,135 +5640,165 @@ func schema_obot_platform_obot_apiclient_types_Prompt(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Field", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.Step"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_PromptResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_Table(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"response": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TableList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Table"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Table"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_ProviderConfigurationParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_Task(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"friendlyName": {
+					"TaskManifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
 						},
 					},
-					"description": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"sensitive": {
+					"alias": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"hidden": {
+					"managed": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"Metadata", "TaskManifest", "managed"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_RemainingTokenUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskConcurrency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TaskConcurrency controls what happens when a schedule, webhook, or email triggers a task while another run of the task is running.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Policy is one of \"allow\", \"forbid\", \"replace\", or \"queue\". The default is \"allow\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"promptTokens": {
+					"maxQueueDepth": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "MaxQueueDepth is the maximum number of runs that wait with the queue policy. Runs that are triggered when the queue is full are skipped. If unset, the queue is not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"completionTokens": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TaskEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attachments": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"),
 						},
 					},
-					"unlimitedPromptTokens": {
+					"reply": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "Reply sends the output of each run as a reply to the email that triggered it.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"unlimitedCompletionTokens": {
+					"senderAuthentication": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "SenderAuthentication is \"allow\", \"tag\", or \"reject\". The default is \"allow\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"promptTokens", "completionTokens", "unlimitedPromptTokens", "unlimitedCompletionTokens"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -3929,7 +5811,7 @@ func schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Task"),
 									},
 								},
 							},
@@ -3940,372 +5822,385 @@ func schema_obot_platform_obot_apiclient_types_RemainingTokenUsageList(ref commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.RemainingTokenUsage"},
+			"github.com/obot-platform/obot/apiclient/types.Task"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Run(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"created": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"threadID": {
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskStep"),
+									},
+								},
+							},
 						},
 					},
-					"agentID": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
-					"workflowID": {
+					"webhook": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskWebhook"),
 						},
 					},
-					"workflowStepID": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskEmail"),
 						},
 					},
-					"previousRunID": {
+					"onDemand": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnDemand"),
 						},
 					},
-					"input": {
+					"onSlackMessage": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage"),
 						},
 					},
-					"state": {
+					"onTaskCompletion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "OnTaskCompletion triggers the task when a run of another task in the same project is done.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.TaskOnTaskCompletion"),
 						},
 					},
-					"output": {
+					"concurrency": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskConcurrency"),
 						},
 					},
-					"error": {
+					"notifications": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Notifications are the sinks that are notified of the runs of the task.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationSink"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"input"},
+				Required: []string{"name", "description", "steps", "schedule", "webhook", "email", "onDemand", "onSlackMessage"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.NotificationSink", "github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.TaskConcurrency", "github.com/obot-platform/obot/apiclient/types.TaskEmail", "github.com/obot-platform/obot/apiclient/types.TaskOnDemand", "github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage", "github.com/obot-platform/obot/apiclient/types.TaskOnTaskCompletion", "github.com/obot-platform/obot/apiclient/types.TaskStep", "github.com/obot-platform/obot/apiclient/types.TaskWebhook"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_RunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"params": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Run"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Run"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Schedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskOnSlackMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"interval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Valid values are: \"hourly\", \"daily\", \"weekly\", \"monthly\"",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"hour": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Events are the kinds of Slack events that run the task. The default is mentions of the app only.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"minute": {
+					"channels": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Channels are the IDs of the channels whose mentions, messages, and reactions run the task. If unset, all channels that the app is in are included.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"day": {
+					"reactions": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Reactions are the names of the emoji, such as \"eyes\", whose reactions run the task. If unset, all reactions run the task.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"weekday": {
+					"commands": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Commands are the slash commands, optionally followed by the first words of their text, such as \"/obot summarize\", that run the task. If unset, all slash commands of the app run the task.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"timezone": {
+					"actionIDs": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ActionIDs are the action IDs of the buttons and other interactive elements whose interactions run the task. If unset, all interactions run the task.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"interval", "hour", "minute", "day", "weekday", "timezone"},
 			},
 		},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_SlackReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskOnTaskCompletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"SlackReceiverManifest": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"),
+							Description: "TaskID is the ID of the task whose runs trigger this task.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clientSecret": {
+					"states": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "States are the states of the runs that trigger this task, \"Complete\" and/or \"Error\". The default is both.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"signingSecret": {
+					"passOutput": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "PassOutput adds the output or error of the run to the input of this task.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"Metadata", "SlackReceiverManifest"},
+				Required: []string{"taskID"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_SlackReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiver"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.SlackReceiver"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_SlackReceiverManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SlackReceiverManifest defines the configuration for a Slack receiver",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"appId": {
+					"taskID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppID corresponds to the App ID of the Slack app. It's important to not that this value is not validated. The user can insert whatever they want here. Don't trust this value. Use the value from oauth flow to validate the app.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"clientId": {
+					"threadID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_Step(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"step": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"loop": {
+					"task": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replayOf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayOf is the ID of the run that this run replays.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"Metadata"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_StepTemplateInvoke(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRunDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"runID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"description": {
+					"otherRunID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
-					},
-					"args": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+					},
+					"inputChanged": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"result": {
+					"taskChanged": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_Table(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskRunStepDiff"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.TaskRunStepDiff"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TableList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -4318,7 +6213,7 @@ func schema_obot_platform_obot_apiclient_types_TableList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Table"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskRun"),
 									},
 								},
 							},
@@ -4329,167 +6224,264 @@ func schema_obot_platform_obot_apiclient_types_TableList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Table"},
+			"github.com/obot-platform/obot/apiclient/types.TaskRun"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_Task(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRunReplayRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TaskRunReplayRequest is the body used to replay a run. Unset fields are copied from the run being replayed.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"TaskManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
-						},
-					},
-					"projectID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"alias": {
+					"input": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"managed": {
+					"task": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
 						},
 					},
 				},
-				Required: []string{"Metadata", "TaskManifest", "managed"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest"},
+			"github.com/obot-platform/obot/apiclient/types.TaskManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskEmail(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRunStepDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stepID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"changed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Changed is true when the step, state, output, error, or tool calls of the step differ between the runs. Differences in token usage alone do not count as a change.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"run": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Run is the result of the step in the run, or nil if the step did not run.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.TaskRunStepResult"),
+						},
+					},
+					"other": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Other is the result of the step in the other run, or nil if the step did not run.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.TaskRunStepResult"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.TaskRunStepResult"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskRunStepResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"step": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"toolCalls": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Task"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"tokenUsage": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TokenUsage"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"tokenUsage"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Task"},
+			"github.com/obot-platform/obot/apiclient/types.TokenUsage"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"description": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"steps": {
+					"loop": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"if": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StepIf"),
+						},
+					},
+					"switch": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.StepSwitch"),
+						},
+					},
+					"parallel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallel steps run at the same time. Step, if set, is used as the instructions for joining their outputs.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskStep"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.Step"),
 									},
 								},
 							},
 						},
 					},
-					"schedule": {
+					"outputSchema": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
+							Description: "OutputSchema is a JSON Schema, as a JSON string, that the output of the step must match. The model is asked to respond with JSON that matches it and is asked to correct its response if it does not.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"webhook": {
+					"approval": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskWebhook"),
+							Description: "Approval pauses the task until a user approves or rejects it.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepApproval"),
 						},
 					},
-					"email": {
+					"task": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskEmail"),
+							Description: "Task runs another task in the same project and waits for its output. Step, if set, is used as the instructions for using its output.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.StepTask"),
 						},
 					},
-					"onDemand": {
+					"concurrency": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnDemand"),
+							Description: "Concurrency is the maximum number of loop elements or parallel steps that run at the same time. Loops run one element at a time by default and parallel steps all run at once by default.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"onSlackMessage": {
+					"retries": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage"),
+							Description: "Retries is the number of times the step is run again after it fails.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff is how long to wait before the first retry, such as \"30s\". The wait doubles for each retry after that.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is how long each attempt of the step can run before it fails, such as \"5m\". The default is 10 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "description", "steps", "schedule", "webhook", "email", "onDemand", "onSlackMessage"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.TaskEmail", "github.com/obot-platform/obot/apiclient/types.TaskOnDemand", "github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage", "github.com/obot-platform/obot/apiclient/types.TaskStep", "github.com/obot-platform/obot/apiclient/types.TaskWebhook"},
+			"github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.StepApproval", "github.com/obot-platform/obot/apiclient/types.StepIf", "github.com/obot-platform/obot/apiclient/types.StepSwitch", "github.com/obot-platform/obot/apiclient/types.StepTask"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TaskWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter, Params, DeliveryIDHeader, Synchronous, and SynchronousTimeout are the same as those of a webhook.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"params": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
@@ -4505,90 +6497,64 @@ func schema_obot_platform_obot_apiclient_types_TaskOnDemand(ref common.Reference
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_TaskOnSlackMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_TaskRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"taskID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"threadID": {
+					"deliveryIDHeader": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"input": {
+					"synchronous": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"output": {
+					"synchronousTimeout": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"task": {
+				},
+			},
+		},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_TeamsReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskManifest"),
-						},
-					},
-					"startTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
 						},
 					},
-					"endTime": {
+					"TeamsReceiverManifest": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TeamsReceiverManifest"),
 						},
 					},
-					"error": {
+					"appPassword": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"Metadata"},
+				Required: []string{"Metadata", "TeamsReceiverManifest"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TaskManifest", "github.com/obot-platform/obot/apiclient/types.Time"},
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.TeamsReceiverManifest"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TeamsReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -4601,7 +6567,7 @@ func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceC
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TaskRun"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.TeamsReceiver"),
 									},
 								},
 							},
@@ -4612,40 +6578,29 @@ func schema_obot_platform_obot_apiclient_types_TaskRunList(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.TaskRun"},
+			"github.com/obot-platform/obot/apiclient/types.TeamsReceiver"},
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_TeamsReceiverManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TeamsReceiverManifest defines the configuration for a Microsoft Teams receiver",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"step": {
+					"appId": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AppID is the Microsoft App ID of the Azure Bot. Activities are only accepted if they were issued for this app.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"loop": {
+					"tenantId": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "TenantID is the Microsoft Entra tenant of a single-tenant bot. If set, only activities from this tenant are accepted and the bot gets its tokens from this tenant.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -4654,16 +6609,6 @@ func schema_obot_platform_obot_apiclient_types_TaskStep(ref common.ReferenceCall
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_TaskWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
-	}
-}
-
 func schema_obot_platform_obot_apiclient_types_TemplateAuthorization(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5054,12 +6999,26 @@ func schema_obot_platform_obot_apiclient_types_ThreadManifest(ref common.Referen
 							},
 						},
 					},
+					"notifications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Notifications are the sinks that are notified of the runs of all tasks of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationSink"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "icons", "introductionMessage", "starterMessages", "prompt"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
+			"github.com/obot-platform/obot/apiclient/types.AgentIcons", "github.com/obot-platform/obot/apiclient/types.NotificationSink", "github.com/obot-platform/obot/apiclient/types.WebsiteKnowledge"},
 	}
 }
 
@@ -5151,6 +7110,24 @@ func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"taskID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"promptTokens": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
@@ -5158,6 +7135,13 @@ func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCa
 							Format:  "int32",
 						},
 					},
+					"cachedPromptTokens": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
 					"completionTokens": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
@@ -5172,13 +7156,20 @@ func schema_obot_platform_obot_apiclient_types_TokenUsage(ref common.ReferenceCa
 							Format:  "int32",
 						},
 					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
 					"date": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
 						},
 					},
 				},
-				Required: []string{"promptTokens", "completionTokens", "totalTokens", "date"},
+				Required: []string{"promptTokens", "cachedPromptTokens", "completionTokens", "totalTokens", "cost", "date"},
 			},
 		},
 		Dependencies: []string{
@@ -5634,41 +7625,218 @@ func schema_obot_platform_obot_apiclient_types_User(ref common.ReferenceCallback
 					},
 					"timezone": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"currentAuthProvider": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastActiveDay": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"internal": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"dailyPromptTokensLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"dailyCompletionTokensLimit": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"Metadata", "lastActiveDay"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.User"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.User"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"WebhookManifest": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookManifest"),
+						},
+					},
+					"aliasAssigned": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"lastSuccessfulRunCompleted": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"hasToken": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"Metadata", "WebhookManifest"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.WebhookManifest"},
+	}
+}
+
+func schema_obot_platform_obot_apiclient_types_WebhookDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
+						},
+					},
+					"webhookID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"deliveryID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliveryID is the value of the DeliveryIDHeader of the webhook in the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"bodySize": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"currentAuthProvider": {
+					"bodyHash": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"lastActiveDay": {
+					"bodyTruncated": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
+							Description: "BodyTruncated is whether the body was too large to be saved in full, so that the delivery cannot be redelivered.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"internal": {
+					"result": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"dailyPromptTokensLimit": {
+					"workflowExecutionIDs": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "WorkflowExecutionIDs are the executions that were started for the delivery, the first when it was received and the rest when it was redelivered.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"dailyCompletionTokensLimit": {
+					"duplicates": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Duplicates is the number of times the delivery was received again with the same delivery ID.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"Metadata", "lastActiveDay"},
+				Required: []string{"Metadata", "webhookID", "receivedAt", "bodySize", "bodyHash", "result"},
 			},
 		},
 		Dependencies: []string{
@@ -5676,7 +7844,7 @@ func schema_obot_platform_obot_apiclient_types_User(ref common.ReferenceCallback
 	}
 }
 
-func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_obot_platform_obot_apiclient_types_WebhookDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -5689,7 +7857,7 @@ func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCall
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/apiclient/types.User"),
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookDelivery"),
 									},
 								},
 							},
@@ -5700,51 +7868,7 @@ func schema_obot_platform_obot_apiclient_types_UserList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.User"},
-	}
-}
-
-func schema_obot_platform_obot_apiclient_types_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"Metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.Metadata"),
-						},
-					},
-					"WebhookManifest": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.WebhookManifest"),
-						},
-					},
-					"aliasAssigned": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"lastSuccessfulRunCompleted": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/apiclient/types.Time"),
-						},
-					},
-					"hasToken": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-				},
-				Required: []string{"Metadata", "WebhookManifest"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Metadata", "github.com/obot-platform/obot/apiclient/types.Time", "github.com/obot-platform/obot/apiclient/types.WebhookManifest"},
+			"github.com/obot-platform/obot/apiclient/types.WebhookDelivery"},
 	}
 }
 
@@ -5838,6 +7962,64 @@ func schema_obot_platform_obot_apiclient_types_WebhookManifest(ref common.Refere
 							Format:  "",
 						},
 					},
+					"signaturePreset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignaturePreset is the format of the signature of the requests, for providers that sign requests with the secret. If ValidationHeader is empty, the header of the provider is used. If SignaturePreset is empty, the header must contain a hex-encoded HMAC-SHA256 of the body.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"signatureTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureTolerance is how old the timestamp of a signature can be, such as \"5m\", for the presets that sign a timestamp. Older requests are rejected so that they cannot be replayed. The default is 5 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is an expression, such as `payload.action == \"opened\"`, that must be true for a request to run the workflow. Requests that do not match are accepted without running it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Params maps the parameters of the workflow to expressions, such as \"payload.pull_request.title\". If set, the parameters are the input of the workflow instead of the payload and headers of the request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deliveryIDHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliveryIDHeader is the header, such as X-GitHub-Delivery, with the unique ID of each delivery. Deliveries with the ID of an earlier delivery are accepted without running the workflow again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"synchronous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Synchronous makes requests wait for the run of the workflow to finish and respond with its output. If the run does not finish within SynchronousTimeout, the response is a WebhookPendingResponse with status 202.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"synchronousTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SynchronousTimeout is how long a synchronous request waits, such as \"30s\". The default is 30 seconds and the maximum is 5 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "description", "alias", "workflowName", "headers", "secret", "validationHeader"},
 			},
@@ -5845,6 +8027,48 @@ func schema_obot_platform_obot_apiclient_types_WebhookManifest(ref common.Refere
 	}
 }
 
+func schema_obot_platform_obot_apiclient_types_WebhookPendingResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WebhookPendingResponse is the response to a request to a synchronous webhook whose run has not finished. The output of the run can be retrieved from StatusURL once it finishes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"deliveryID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"workflowExecutionID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"statusURL": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"deliveryID", "workflowExecutionID", "state", "statusURL"},
+			},
+		},
+	}
+}
+
 func schema_obot_platform_obot_apiclient_types_WebsiteCrawlingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6133,12 +8357,35 @@ func schema_obot_platform_obot_apiclient_types_WorkflowManifest(ref common.Refer
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage"),
 						},
 					},
+					"onTaskCompletion": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskOnTaskCompletion"),
+						},
+					},
+					"concurrency": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/obot-platform/obot/apiclient/types.TaskConcurrency"),
+						},
+					},
+					"notifications": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationSink"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"alias", "steps", "output"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage"},
+			"github.com/obot-platform/obot/apiclient/types.NotificationSink", "github.com/obot-platform/obot/apiclient/types.Step", "github.com/obot-platform/obot/apiclient/types.TaskConcurrency", "github.com/obot-platform/obot/apiclient/types.TaskOnSlackMessage", "github.com/obot-platform/obot/apiclient/types.TaskOnTaskCompletion"},
 	}
 }
 
@@ -6748,6 +8995,48 @@ func schema_storage_apis_obotobotai_v1_CronJobSpec(ref common.ReferenceCallback)
 							Ref: ref("github.com/obot-platform/obot/apiclient/types.Schedule"),
 						},
 					},
+					"catchUp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CatchUp is what to do about ticks of the schedule that were missed, such as while the server was down. The default is \"runOnce\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jitter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jitter is the maximum random delay added to each run, such as \"10m\", to spread out runs that are scheduled at the same time.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"blackouts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blackouts are ranges of dates on which the schedule does not run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"),
+									},
+								},
+							},
+						},
+					},
+					"endAfterRuns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfterRuns stops the schedule after it has run this many times.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"endAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndAfter stops the schedule after this date, such as \"2025-12-31\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -6758,7 +9047,7 @@ func schema_storage_apis_obotobotai_v1_CronJobSpec(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.Schedule"},
+			"github.com/obot-platform/obot/apiclient/types.Schedule", "github.com/obot-platform/obot/apiclient/types.ScheduleBlackout"},
 	}
 }
 
@@ -6778,6 +9067,19 @@ func schema_storage_apis_obotobotai_v1_CronJobStatus(ref common.ReferenceCallbac
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"lastScheduledTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScheduledTime is the tick of the schedule that was last run or skipped.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"runCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunCount is the number of times the schedule has run.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -6910,13 +9212,64 @@ func schema_storage_apis_obotobotai_v1_DefaultModelAliasStatus(ref common.Refere
 				Properties: map[string]spec.Schema{
 					"setAliasName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"setAliasName"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_EmailMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"messageID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"references": {
+						SchemaProps: spec.SchemaProps{
+							Description: "References are the message IDs of the emails before this one in the same thread.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"setAliasName"},
 			},
 		},
 	}
@@ -7063,6 +9416,26 @@ func schema_storage_apis_obotobotai_v1_EmailReceiverSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"attachments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attachments limits the attachments that are saved to the workspace of the triggered task run.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"),
+						},
+					},
+					"reply": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reply sends the output of the triggered task run as a reply to the email. Replies to the reply continue the same task run instead of starting a new one.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"senderAuthentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SenderAuthentication is what to do with emails that are not authenticated by the domain of their sender.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -7073,6 +9446,8 @@ func schema_storage_apis_obotobotai_v1_EmailReceiverSpec(ref common.ReferenceCal
 				Required: []string{"name", "description", "workflowName"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.EmailAttachmentOptions"},
 	}
 }
 
@@ -7100,6 +9475,51 @@ func schema_storage_apis_obotobotai_v1_EmailReceiverStatus(ref common.ReferenceC
 	}
 }
 
+func schema_storage_apis_obotobotai_v1_EmailReplyFailure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"messageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessageID is the message ID of the reply that failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"lastAttempt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"final": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Final is whether the reply is not retried, because the relay rejected it permanently or the attempts ran out.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_storage_apis_obotobotai_v1_EmptyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8612,15 +11032,240 @@ func schema_storage_apis_obotobotai_v1_ModelStatus(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
-					"observedGeneration": {
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_NotificationAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"statusCode": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_NotificationDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliverySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliveryStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliverySpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDeliveryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_NotificationDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDelivery"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationDelivery", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_NotificationDeliverySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadName is the project of the task.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workflowName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowExecutionName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"event": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sink": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sink is a copy of the sink when the event happened, so that retries are sent to the same place.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.NotificationSink"),
+						},
+					},
+					"payload": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.NotificationPayload"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.NotificationPayload", "github.com/obot-platform/obot/apiclient/types.NotificationSink"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_NotificationDeliveryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"attempts": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationAttempt"),
+									},
+								},
+							},
+						},
+					},
+					"sentTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SentTo are the recipients of an email sink that were sent the notification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.NotificationAttempt"},
 	}
 }
 
@@ -8987,18 +11632,252 @@ func schema_storage_apis_obotobotai_v1_Run(ref common.ReferenceCallback) common.
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_RunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Run"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Run", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_RunSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"synchronous": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"agentName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowExecutionName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowStepName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"workflowStepID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"previousRunName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tool": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"toolReferenceType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"credentialContextIDs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"defaultModel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"externalCallResults": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"),
+									},
+								},
+							},
+						},
+					},
+					"waitFor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WaitFor is an external call, such as an approval, that the run waits for instead of running a tool. The result of the call is the output of the run, and the conversation of the previous run is passed through unchanged.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall"),
+						},
+					},
+				},
+				Required: []string{"input"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_RunState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStateSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_RunStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9031,7 +11910,7 @@ func schema_storage_apis_obotobotai_v1_RunList(ref common.ReferenceCallback) com
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Run"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunState"),
 									},
 								},
 							},
@@ -9042,145 +11921,122 @@ func schema_storage_apis_obotobotai_v1_RunList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.Run", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunState", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_RunStateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"synchronous": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
 					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"agentName": {
+					"program": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "",
+							Format: "byte",
 						},
 					},
-					"workflowName": {
+					"chatState": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "",
+							Format: "byte",
 						},
 					},
-					"workflowExecutionName": {
+					"callFrame": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "",
+							Format: "byte",
 						},
 					},
-					"workflowStepName": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "",
+							Format: "byte",
 						},
 					},
-					"workflowStepID": {
+					"done": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
-					"previousRunName": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"input": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"env": {
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_RunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"tool": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"toolReferenceType": {
+					"output": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"credentialContextIDs": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"defaultModel": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"externalCallResults": {
+					"externalCall": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult"),
-									},
-								},
-							},
+							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall"),
 						},
 					},
 				},
-				Required: []string{"input"},
+				Required: []string{"output"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCallResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_SlackReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9209,24 +12065,24 @@ func schema_storage_apis_obotobotai_v1_RunState(ref common.ReferenceCallback) co
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStateSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunStateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_SlackReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9259,7 +12115,7 @@ func schema_storage_apis_obotobotai_v1_RunStateList(ref common.ReferenceCallback
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunState"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiver"),
 									},
 								},
 							},
@@ -9270,126 +12126,186 @@ func schema_storage_apis_obotobotai_v1_RunStateList(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.RunState", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiver", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunStateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_SlackReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"threadName": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"),
 						},
 					},
-					"program": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"chatState": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_SlackReceiverStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_SlackTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"callFrame": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"output": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"done": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerSpec"),
 						},
 					},
-					"error": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_RunStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_SlackTriggerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTrigger"),
 									},
 								},
 							},
 						},
 					},
-					"state": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTrigger", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_SlackTriggerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"output": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"endTime": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"error": {
+					"teamID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"externalCall": {
+					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"output"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.ExternalCall", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_SlackTriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_TeamsMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TeamsMessage is a message from a Teams conversation that is run in the thread of the conversation and answered with a reply. It is named after the ID of the activity, so that an activity that the Bot Framework sends again is only run once.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -9414,24 +12330,24 @@ func schema_storage_apis_obotobotai_v1_SlackReceiver(ref common.ReferenceCallbac
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiverStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessageStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsMessageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9464,7 +12380,7 @@ func schema_storage_apis_obotobotai_v1_SlackReceiverList(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiver"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessage"),
 									},
 								},
 							},
@@ -9475,47 +12391,83 @@ func schema_storage_apis_obotobotai_v1_SlackReceiverList(ref common.ReferenceCal
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackReceiver", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsMessage", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsMessageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"manifest": {
+					"receiverName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 					"threadName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ThreadName is the thread of the conversation of the message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"input": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Input is the text that the thread is run with.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"activity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Activity is the JSON of the message activity, which the reply is sent for.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.SlackReceiverManifest"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackReceiverStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsMessageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"runName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunName is the run of the thread for the message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replied is whether the output of the run was sent as a reply to the message.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9544,24 +12496,24 @@ func schema_storage_apis_obotobotai_v1_SlackTrigger(ref common.ReferenceCallback
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTriggerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiverStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackTriggerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -9594,7 +12546,7 @@ func schema_storage_apis_obotobotai_v1_SlackTriggerList(ref common.ReferenceCall
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTrigger"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiver"),
 									},
 								},
 							},
@@ -9605,26 +12557,20 @@ func schema_storage_apis_obotobotai_v1_SlackTriggerList(ref common.ReferenceCall
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.SlackTrigger", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.TeamsReceiver", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackTriggerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"appID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"teamID": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/apiclient/types.TeamsReceiverManifest"),
 						},
 					},
 					"threadName": {
@@ -9636,10 +12582,12 @@ func schema_storage_apis_obotobotai_v1_SlackTriggerSpec(ref common.ReferenceCall
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.TeamsReceiverManifest"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_SlackTriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_TeamsReceiverStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -10753,39 +13701,197 @@ func schema_storage_apis_obotobotai_v1_ToolSpec(ref common.ReferenceCallback) co
 							Ref:     ref("github.com/obot-platform/obot/apiclient/types.ToolManifest"),
 						},
 					},
-					"envs": {
+					"envs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.ToolManifest"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_ToolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_UserDelete(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_UserDeleteList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDelete"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDelete", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_UserDeleteSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_storage_apis_obotobotai_v1_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookSpec"),
+						},
+					},
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.ToolManifest"},
-	}
-}
-
-func schema_storage_apis_obotobotai_v1_ToolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_UserDelete(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_WebhookDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -10814,24 +13920,24 @@ func schema_storage_apis_obotobotai_v1_UserDelete(ref common.ReferenceCallback)
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteSpec"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliverySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus"),
+							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliveryStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmptyStatus", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDeleteSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliverySpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDeliveryStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_UserDeleteList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_WebhookDeliveryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -10864,7 +13970,7 @@ func schema_storage_apis_obotobotai_v1_UserDeleteList(ref common.ReferenceCallba
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDelete"),
+										Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDelivery"),
 									},
 								},
 							},
@@ -10875,71 +13981,124 @@ func schema_storage_apis_obotobotai_v1_UserDeleteList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.UserDelete", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookDelivery", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_UserDeleteSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_WebhookDeliverySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"userID": {
+					"webhookName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deliveryID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"receivedAt": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers and Body are those of the request, so that the delivery can be redelivered. The body of a request that was rejected is not saved.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"bodyTruncated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BodyTruncated is whether only the start of the body was saved because it is large, in which case the delivery cannot be redelivered.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bodySize": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
 							Format: "int32",
 						},
 					},
+					"bodyHash": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"resultKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResultKey is the secret key that is required to get the result of a delivery to a synchronous webhook.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_storage_apis_obotobotai_v1_Webhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_storage_apis_obotobotai_v1_WebhookDeliveryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"workflowExecutionNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookSpec"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"duplicates": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookStatus"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookSpec", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.WebhookStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -11053,6 +14212,64 @@ func schema_storage_apis_obotobotai_v1_WebhookSpec(ref common.ReferenceCallback)
 							Format:  "",
 						},
 					},
+					"signaturePreset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignaturePreset is the format of the signature of the requests, for providers that sign requests with the secret. If ValidationHeader is empty, the header of the provider is used. If SignaturePreset is empty, the header must contain a hex-encoded HMAC-SHA256 of the body.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"signatureTolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SignatureTolerance is how old the timestamp of a signature can be, such as \"5m\", for the presets that sign a timestamp. Older requests are rejected so that they cannot be replayed. The default is 5 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Filter is an expression, such as `payload.action == \"opened\"`, that must be true for a request to run the workflow. Requests that do not match are accepted without running it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"params": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Params maps the parameters of the workflow to expressions, such as \"payload.pull_request.title\". If set, the parameters are the input of the workflow instead of the payload and headers of the request.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"deliveryIDHeader": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeliveryIDHeader is the header, such as X-GitHub-Delivery, with the unique ID of each delivery. Deliveries with the ID of an earlier delivery are accepted without running the workflow again.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"synchronous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Synchronous makes requests wait for the run of the workflow to finish and respond with its output. If the run does not finish within SynchronousTimeout, the response is a WebhookPendingResponse with status 202.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"synchronousTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SynchronousTimeout is how long a synchronous request waits, such as \"30s\". The default is 30 seconds and the maximum is 5 minutes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"tokenHash": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -11313,9 +14530,44 @@ func schema_storage_apis_obotobotai_v1_WorkflowExecutionSpec(ref common.Referenc
 							Format:      "",
 						},
 					},
+					"manifest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manifest is used instead of the manifest of the workflow, such as when a run is replayed with an edited task.",
+							Ref:         ref("github.com/obot-platform/obot/apiclient/types.WorkflowManifest"),
+						},
+					},
+					"replayOf": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReplayOf is the name of the execution that this execution replays.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggeredBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredBy is the name of the execution whose completion started this execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachmentsWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachmentsWorkspaceID is the ID of a workspace with files, such as email attachments, that are copied into the workspace of the thread of this execution before it runs. The workspace is deleted once they are copied, or when the execution is deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emailMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailMessage is the email that started this execution, or that continued it, when the output of the execution is sent as a reply to it.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailMessage"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/obot-platform/obot/apiclient/types.WorkflowManifest", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailMessage"},
 	}
 }
 
@@ -11365,11 +14617,38 @@ func schema_storage_apis_obotobotai_v1_WorkflowExecutionStatus(ref common.Refere
 							Format: "int64",
 						},
 					},
+					"copiedAttachmentsWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CopiedAttachmentsWorkspaceID is the ID of the attachments workspace whose files were copied to the thread.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletedAttachmentsWorkspaceID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeletedAttachmentsWorkspaceID is the ID of the last attachments workspace that was deleted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emailReplyMessageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailReplyMessageID is the message ID of the last reply sent to the email of the execution.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"emailReplyFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EmailReplyFailure is the failure to send the latest reply, if it has not been sent.",
+							Ref:         ref("github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReplyFailure"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/obot-platform/obot/apiclient/types.WorkflowManifest", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/obot-platform/obot/apiclient/types.WorkflowManifest", "github.com/obot-platform/obot/pkg/storage/apis/obot.obot.ai/v1.EmailReplyFailure", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -11470,8 +14749,24 @@ func schema_storage_apis_obotobotai_v1_WorkflowStatus(ref common.ReferenceCallba
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"onTaskCompletionTaskID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OnTaskCompletionTaskID and OnTaskCompletionSince record which task the task completion trigger of the workflow is for and since when. Only runs of that task that are done after then trigger the workflow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"onTaskCompletionSince": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -11599,6 +14894,13 @@ func schema_storage_apis_obotobotai_v1_WorkflowStepSpec(ref common.ReferenceCall
 							Format: "int64",
 						},
 					},
+					"threadName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ThreadName is the thread that the runs of the step use instead of the thread of the workflow execution, so that steps that run at the same time, such as the lanes of a parallel step, don't share a thread.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -11657,6 +14959,27 @@ func schema_storage_apis_obotobotai_v1_WorkflowStepStatus(ref common.ReferenceCa
 							Format: "",
 						},
 					},
+					"structuredOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StructuredOutput is the output of a step that does not come from its last run, such as the JSON output of a step with an output schema after it was validated, or the output of a sub-task",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"outputRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutputRetries is the number of runs that were made to correct output that did not match the output schema",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"branch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Branch is the branch taken by an if or switch step",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3,33 +3,37 @@ package system
 import "strings"
 
 const (
-	ThreadPrefix              = "t1"
-	ThreadSharePrefix         = "ts1"
-	ThreadAuthorizationPrefix = "ta1"
-	AgentPrefix               = "a1"
-	RunPrefix                 = "r1"
-	ChatRunPrefix             = "r1chat"
-	WorkflowPrefix            = "w1"
-	WorkflowExecutionPrefix   = "we1"
-	WorkflowStepPrefix        = "ws1"
-	WorkspacePrefix           = "wksp1"
-	WebhookPrefix             = "wh1"
-	CronJobPrefix             = "cj1"
-	KnowledgeSourcePrefix     = "ks1"
-	OAuthAppPrefix            = "oa1"
-	KnowledgeSetPrefix        = "kst1"
-	OAuthAppLoginPrefix       = "oal1"
-	EmailReceiverPrefix       = "er1"
-	ModelPrefix               = "m1"
-	AliasPrefix               = "al1"
-	DefaultModelAliasPrefix   = "dma1"
-	ToolPrefix                = "tl1"
-	ProjectPrefix             = "p1"
-	ThreadTemplatePrefix      = "tt1"
-	SlackReceiverPrefix       = "sr1"
-	SlackTriggerPrefix        = "st1"
-	UserDeletePrefix          = "ud1"
-	MCPServerPrefix           = "ms1"
+	ThreadPrefix               = "t1"
+	ThreadSharePrefix          = "ts1"
+	ThreadAuthorizationPrefix  = "ta1"
+	AgentPrefix                = "a1"
+	RunPrefix                  = "r1"
+	ChatRunPrefix              = "r1chat"
+	WorkflowPrefix             = "w1"
+	WorkflowExecutionPrefix    = "we1"
+	WorkflowStepPrefix         = "ws1"
+	WorkspacePrefix            = "wksp1"
+	WebhookPrefix              = "wh1"
+	WebhookDeliveryPrefix      = "whd1"
+	NotificationDeliveryPrefix = "nd1"
+	CronJobPrefix              = "cj1"
+	KnowledgeSourcePrefix      = "ks1"
+	OAuthAppPrefix             = "oa1"
+	KnowledgeSetPrefix         = "kst1"
+	OAuthAppLoginPrefix        = "oal1"
+	EmailReceiverPrefix        = "er1"
+	ModelPrefix                = "m1"
+	AliasPrefix                = "al1"
+	DefaultModelAliasPrefix    = "dma1"
+	ToolPrefix                 = "tl1"
+	ProjectPrefix              = "p1"
+	ThreadTemplatePrefix       = "tt1"
+	SlackReceiverPrefix        = "sr1"
+	SlackTriggerPrefix         = "st1"
+	TeamsReceiverPrefix        = "tr1"
+	TeamsMessagePrefix         = "tm1"
+	UserDeletePrefix           = "ud1"
+	MCPServerPrefix            = "ms1"
 )
 
 func IsThreadID(id string) bool {
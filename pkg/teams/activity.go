@@ -0,0 +1,59 @@
+package teams
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Activity is a message or other event of the Bot Framework activity protocol. Only the fields that are used are
+// included.
+type Activity struct {
+	Type         string               `json:"type"`
+	ID           string               `json:"id,omitempty"`
+	ServiceURL   string               `json:"serviceUrl,omitempty"`
+	ChannelID    string               `json:"channelId,omitempty"`
+	From         *ChannelAccount      `json:"from,omitempty"`
+	Conversation *ConversationAccount `json:"conversation,omitempty"`
+	Recipient    *ChannelAccount      `json:"recipient,omitempty"`
+	Text         string               `json:"text,omitempty"`
+	TextFormat   string               `json:"textFormat,omitempty"`
+	ReplyToID    string               `json:"replyToId,omitempty"`
+	Entities     []Entity             `json:"entities,omitempty"`
+}
+
+type ChannelAccount struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	AADObjectID string `json:"aadObjectId,omitempty"`
+}
+
+// Entity is metadata of an activity, such as a mention of a user or bot in the text of a message.
+type Entity struct {
+	Type      string          `json:"type"`
+	Mentioned *ChannelAccount `json:"mentioned,omitempty"`
+	Text      string          `json:"text,omitempty"`
+}
+
+type ConversationAccount struct {
+	ID               string `json:"id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	ConversationType string `json:"conversationType,omitempty"`
+	TenantID         string `json:"tenantId,omitempty"`
+	IsGroup          bool   `json:"isGroup,omitempty"`
+}
+
+var mentionPattern = regexp.MustCompile(`<at>([^<]*)</at>`)
+
+// MessageText returns the text of a message without the mentions of the bot, which Teams adds to messages in
+// channels and group chats. Mentions of anyone else are replaced with their name.
+func MessageText(activity Activity) string {
+	text := activity.Text
+	if activity.Recipient != nil {
+		for _, entity := range activity.Entities {
+			if entity.Type == "mention" && entity.Mentioned != nil && entity.Mentioned.ID == activity.Recipient.ID && entity.Text != "" {
+				text = strings.ReplaceAll(text, entity.Text, "")
+			}
+		}
+	}
+	return strings.TrimSpace(mentionPattern.ReplaceAllString(text, "$1"))
+}
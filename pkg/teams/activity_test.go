@@ -0,0 +1,68 @@
+package teams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageText(t *testing.T) {
+	bot := &ChannelAccount{ID: "28:bot", Name: "Obot"}
+
+	tests := []struct {
+		name     string
+		activity Activity
+		want     string
+	}{
+		{
+			name:     "personal chat",
+			activity: Activity{Recipient: bot, Text: "hello"},
+			want:     "hello",
+		},
+		{
+			name: "mention of the bot",
+			activity: Activity{
+				Recipient: bot,
+				Text:      "<at>Obot</at> hello",
+				Entities: []Entity{
+					{Type: "mention", Mentioned: bot, Text: "<at>Obot</at>"},
+				},
+			},
+			want: "hello",
+		},
+		{
+			name: "mention of someone else",
+			activity: Activity{
+				Recipient: bot,
+				Text:      "<at>Obot</at> ask <at>Alice</at> about it",
+				Entities: []Entity{
+					{Type: "mention", Mentioned: bot, Text: "<at>Obot</at>"},
+					{Type: "mention", Mentioned: &ChannelAccount{ID: "29:alice", Name: "Alice"}, Text: "<at>Alice</at>"},
+				},
+			},
+			want: "ask Alice about it",
+		},
+		{
+			name: "someone with the name of the bot",
+			activity: Activity{
+				Recipient: bot,
+				Text:      "<at>Obot</at> hello",
+				Entities: []Entity{
+					{Type: "mention", Mentioned: &ChannelAccount{ID: "29:obot", Name: "Obot"}, Text: "<at>Obot</at>"},
+				},
+			},
+			want: "Obot hello",
+		},
+		{
+			name:     "only a mention of the bot",
+			activity: Activity{Recipient: bot, Text: "<at>Obot</at> ", Entities: []Entity{{Type: "mention", Mentioned: bot, Text: "<at>Obot</at>"}}},
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MessageText(tt.activity))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package teams
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	openIDConfigurationURL = "https://login.botframework.com/v1/.well-known/openidconfiguration"
+	botFrameworkIssuer     = "https://api.botframework.com"
+	keysRefreshInterval    = 24 * time.Hour
+)
+
+type signingKey struct {
+	key          *rsa.PublicKey
+	endorsements []string
+}
+
+// Validator validates the tokens that the Bot Framework sends with the activities for a bot.
+type Validator struct {
+	client *http.Client
+
+	lock    sync.Mutex
+	keys    map[string]signingKey
+	fetched time.Time
+	// attempted is when the keys were last fetched, whether or not that succeeded.
+	attempted time.Time
+}
+
+func NewValidator() *Validator {
+	return &Validator{
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Audience returns the app ID that the token was issued for, without validating the token, so that the app to
+// validate it for can be found.
+func Audience(token string) (string, error) {
+	claims := jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, &claims); err != nil {
+		return "", fmt.Errorf("invalid token: %w", err)
+	}
+	if len(claims.Audience) != 1 {
+		return "", fmt.Errorf("invalid token: expected one audience")
+	}
+	return claims.Audience[0], nil
+}
+
+type botFrameworkClaims struct {
+	jwt.RegisteredClaims
+	ServiceURL string `json:"serviceurl"`
+}
+
+// Validate checks that the token was issued by the Bot Framework for the app, and for the channel and service URL of
+// the activity.
+func (v *Validator) Validate(ctx context.Context, token, appID string, activity Activity) error {
+	claims := botFrameworkClaims{}
+	if _, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if len(key.endorsements) > 0 && !slices.Contains(key.endorsements, activity.ChannelID) {
+			return nil, fmt.Errorf("signing key is not endorsed for channel %q", activity.ChannelID)
+		}
+		return key.key, nil
+	},
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(botFrameworkIssuer),
+		jwt.WithAudience(appID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(5*time.Minute),
+	); err != nil {
+		return fmt.Errorf("invalid token: %w", err)
+	}
+
+	if claims.ServiceURL != activity.ServiceURL {
+		return fmt.Errorf("invalid token: service URL %q does not match the activity", claims.ServiceURL)
+	}
+
+	return nil
+}
+
+// key returns the signing key with the ID, fetching the keys again if they are old or the key is unknown, because
+// the Bot Framework rotates its keys.
+func (v *Validator) key(ctx context.Context, kid string) (signingKey, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	key, ok := v.keys[kid]
+	if ok && time.Since(v.fetched) < keysRefreshInterval {
+		return key, nil
+	}
+
+	// Don't fetch the keys more than once a minute, including when fetching them failed, so that requests with
+	// unknown key IDs cannot make every request fetch them.
+	if time.Since(v.attempted) < time.Minute {
+		if ok {
+			return key, nil
+		}
+		return signingKey{}, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	v.attempted = time.Now()
+	keys, err := v.fetchKeys(ctx)
+	if err != nil {
+		if ok {
+			// Keep using the old keys until they can be fetched again.
+			return key, nil
+		}
+		return signingKey{}, err
+	}
+	v.keys, v.fetched = keys, time.Now()
+
+	key, ok = v.keys[kid]
+	if !ok {
+		return signingKey{}, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (v *Validator) fetchKeys(ctx context.Context) (map[string]signingKey, error) {
+	var config struct {
+		JWKSURI string `json:"jwks_uri"`
+	}
+	if err := v.getJSON(ctx, openIDConfigurationURL, &config); err != nil {
+		return nil, fmt.Errorf("failed to get Bot Framework OpenID configuration: %w", err)
+	}
+
+	var jwks struct {
+		Keys []struct {
+			KeyType      string   `json:"kty"`
+			KeyID        string   `json:"kid"`
+			N            string   `json:"n"`
+			E            string   `json:"e"`
+			Endorsements []string `json:"endorsements"`
+		} `json:"keys"`
+	}
+	if err := v.getJSON(ctx, config.JWKSURI, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to get Bot Framework signing keys: %w", err)
+	}
+
+	keys := make(map[string]signingKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.KeyType != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.KeyID] = signingKey{
+			key: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+			endorsements: k.Endorsements,
+		}
+	}
+
+	return keys, nil
+}
+
+func (v *Validator) getJSON(ctx context.Context, url string, obj any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(obj)
+}
@@ -0,0 +1,148 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	botFrameworkScope = "https://api.botframework.com/.default"
+	// defaultTenant is the tenant that multi-tenant bots get their tokens from.
+	defaultTenant = "botframework.com"
+)
+
+// CredentialToolName is the tool name of the credential with the password of the app of a Teams receiver.
+const CredentialToolName = "teams"
+
+// tokenExpiryMargin is how long before it expires that an access token is replaced with a new one.
+const tokenExpiryMargin = 5 * time.Minute
+
+// Client sends activities to the conversations of a bot through the Bot Connector service.
+type Client struct {
+	appID, password, tenantID string
+	client                    *http.Client
+
+	lock        sync.Mutex
+	accessToken string
+	expires     time.Time
+}
+
+func NewClient(appID, password, tenantID string) *Client {
+	return &Client{
+		appID:    appID,
+		password: password,
+		tenantID: tenantID,
+		client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Reply sends the text as a reply to the activity, so that it is threaded under the message in Teams channels.
+func (c *Client) Reply(ctx context.Context, activity Activity, text string) error {
+	if activity.Conversation == nil {
+		return fmt.Errorf("activity %s has no conversation", activity.ID)
+	}
+
+	token, err := c.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(Activity{
+		Type:         "message",
+		From:         activity.Recipient,
+		Recipient:    activity.From,
+		Conversation: activity.Conversation,
+		ReplyToID:    activity.ID,
+		Text:         text,
+		TextFormat:   "markdown",
+	})
+	if err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("%s/v3/conversations/%s/activities/%s", strings.TrimSuffix(activity.ServiceURL, "/"),
+		url.PathEscape(activity.Conversation.ID), url.PathEscape(activity.ID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send reply: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to send reply: status %d: %s", resp.StatusCode, msg)
+	}
+	return nil
+}
+
+// Matches returns whether the client uses the credentials.
+func (c *Client) Matches(appID, password, tenantID string) bool {
+	return c.appID == appID && c.password == password && c.tenantID == tenantID
+}
+
+// token returns an access token for the Bot Connector service with the credentials of the bot. The token is reused
+// until it is about to expire.
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.accessToken != "" && time.Until(c.expires) > tokenExpiryMargin {
+		return c.accessToken, nil
+	}
+
+	tenant := c.tenantID
+	if tenant == "" {
+		tenant = defaultTenant
+	}
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.appID},
+		"client_secret": {c.password},
+		"scope":         {botFrameworkScope},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", url.PathEscape(tenant)),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bot token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to get bot token: status %d: %s", resp.StatusCode, msg)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode bot token: %w", err)
+	}
+
+	c.accessToken, c.expires = token.AccessToken, time.Now().Add(time.Duration(token.ExpiresIn)*time.Second)
+	return token.AccessToken, nil
+}